@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/certificate"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/progress"
+	"strings"
+	"testing"
+)
+
+func TestGetCertificatePdf_CompletedCourse_ReturnsPdf(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+	certificateID := completeCourse(environmentCreator, participantID)
+
+	getCertificatePdfResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		certificate.NewGetCertificatePdfHandler,
+		map[string]string{
+			"certificateId": certificateID,
+		})
+
+	if getCertificatePdfResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getCertificatePdfResponse.StatusCode, getCertificatePdfResponse.Body)
+	}
+
+	if getCertificatePdfResponse.Headers["Content-Type"] != "application/pdf" {
+		t.Fatalf("expected pdf content type, got %s", getCertificatePdfResponse.Headers["Content-Type"])
+	}
+
+	pdf := string(err.PanicIfError1(base64.StdEncoding.DecodeString(getCertificatePdfResponse.Body)))
+	if !strings.HasPrefix(pdf, "%PDF-") || !strings.Contains(pdf, certificateID) {
+		t.Fatalf("expected a pdf containing the certificate id")
+	}
+}
+
+func TestGetCertificatePdf_UnknownCertificate_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getCertificatePdfResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		certificate.NewGetCertificatePdfHandler,
+		map[string]string{
+			"certificateId": "unknown",
+		})
+
+	if getCertificatePdfResponse.StatusCode != 404 {
+		t.Fatalf("lambda did not return 404 for unknown certificate, status code: %v, body: %s", getCertificatePdfResponse.StatusCode, getCertificatePdfResponse.Body)
+	}
+}
+
+// completeCourse passes every quiz of the course by answering its first question correctly and returns the issued certificate id.
+func completeCourse(environmentCreator *local.EnvironmentCreator, participantID string) string {
+	c := err.PanicIfError1(inmemory.NewCourseRepository().FindByID(inmemory.CourseIDFrontendDevelopment))
+
+	for _, step := range c.Steps {
+		for _, quiz := range step.Quizzes {
+			question := quiz.Questions[0]
+
+			correctAnswerID := ""
+			for _, answer := range question.Answers {
+				if answer.IsCorrect {
+					correctAnswerID = answer.ID
+				}
+			}
+
+			commands := []string{
+				fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`, quiz.ID, question.ID, command.StartQuizCommandType),
+				fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`, quiz.ID, question.ID, correctAnswerID, command.SelectAnswerCommandType),
+				fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`, quiz.ID, command.FinishQuizCommandType),
+			}
+
+			for _, commandPayload := range commands {
+				response := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, commandPayload)
+				if response.StatusCode != 200 {
+					panic(fmt.Errorf("command failed, status code: %v, body: %s", response.StatusCode, response.Body))
+				}
+			}
+		}
+	}
+
+	getProgressResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(
+		participantID,
+		progress.NewGetParticipantCourseProgressHandler,
+		map[string]string{
+			"courseId": inmemory.CourseIDFrontendDevelopment,
+		})
+
+	return json.GetJSONPathValue(getProgressResponse, "$.certificateId").(string)
+}
@@ -6,6 +6,7 @@ import (
 	"learn-to-code/internal/infrastructure/local"
 	"learn-to-code/internal/infrastructure/testing/json"
 	course "learn-to-code/internal/interfaces/lambda/course"
+	"strings"
 	"testing"
 )
 
@@ -32,3 +33,15 @@ func TestGetCourseLambda_ContainsCourseData(t *testing.T) {
 		t.Fatalf("expected course '%s' not found: '%s'", inmemory.CourseIDFrontendDevelopment, courseID)
 	}
 }
+
+func TestGetCourseLambda_ContainsNoAnswerKey(t *testing.T) {
+
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandler(course.NewLambdaHandler)
+
+	if strings.Contains(handlerResponse.Body, "isCorrect") || strings.Contains(handlerResponse.Body, "description") {
+		t.Fatalf("expected the course without answer key, got %s", handlerResponse.Body)
+	}
+}
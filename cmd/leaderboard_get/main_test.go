@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/leaderboard"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+var passQuizPayloads = []string{
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+}
+
+func TestGetLeaderboard_Returns200WithRankedParticipants(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+	passQuiz(environmentCreator, participantID)
+
+	for _, period := range []string{"all-time", "weekly"} {
+		getLeaderboardResponse := environmentCreator.ExecuteLambdaHandlerGETWithQueryParametersForUser(participantID, leaderboard.NewGetLeaderboardHandler, map[string]string{
+			"courseId": inmemory.CourseIDFrontendDevelopment,
+			"period":   period,
+		}, map[string]string{
+			"metric": "quizzesPassed",
+		})
+
+		if getLeaderboardResponse.StatusCode != 200 {
+			t.Fatalf("lambda did not succeed, status code: %v, body: %s", getLeaderboardResponse.StatusCode, getLeaderboardResponse.Body)
+		}
+
+		score := json.GetJSONPathValue(getLeaderboardResponse, "$.entries[0].score").(float64)
+		isCurrentParticipant := json.GetJSONPathValue(getLeaderboardResponse, "$.entries[0].isCurrentParticipant").(bool)
+
+		if score != 1 || !isCurrentParticipant {
+			t.Fatalf("expected the participant to be ranked with one passed quiz in the %s leaderboard, got %s", period, getLeaderboardResponse.Body)
+		}
+	}
+}
+
+func TestGetLeaderboard_OptedOutParticipant_IsNotRanked(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+	passQuiz(environmentCreator, participantID)
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler,
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"optOut": true}, "type": "%s"}`, command.SetLeaderboardOptOutCommandType))
+	updateLeaderboards(environmentCreator, participantID, event.LeaderboardOptOutSetTypeName)
+
+	getLeaderboardResponse := environmentCreator.ExecuteLambdaHandlerGETWithQueryParametersForUser(participantID, leaderboard.NewGetLeaderboardHandler, map[string]string{
+		"courseId": inmemory.CourseIDFrontendDevelopment,
+		"period":   "all-time",
+	}, map[string]string{})
+
+	totalEntries := json.GetJSONPathValue(getLeaderboardResponse, "$.totalEntries").(float64)
+
+	if totalEntries != 0 {
+		t.Fatalf("expected no ranked participants after opting out, got %s", getLeaderboardResponse.Body)
+	}
+}
+
+func TestGetLeaderboard_UnknownPeriod_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getLeaderboardResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(leaderboard.NewGetLeaderboardHandler, map[string]string{
+		"courseId": inmemory.CourseIDFrontendDevelopment,
+		"period":   "monthly",
+	})
+
+	if getLeaderboardResponse.StatusCode != 400 {
+		t.Fatalf("expected status code 400, got %v", getLeaderboardResponse.StatusCode)
+	}
+}
+
+func passQuiz(environmentCreator *local.EnvironmentCreator, participantID string) {
+	for _, payload := range passQuizPayloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	updateLeaderboards(environmentCreator, participantID, event.FinishedQuizTypeName)
+}
+
+// updateLeaderboards sends the stored event to the stream handler like the DynamoDB stream of the events table does
+func updateLeaderboards(environmentCreator *local.EnvironmentCreator, participantID string, eventType string) {
+	streamHandler := leaderboard.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+
+	err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+		Records: []events.DynamoDBEventRecord{
+			{
+				EventName: string(events.DynamoDBOperationTypeInsert),
+				Change: events.DynamoDBStreamRecord{
+					NewImage: map[string]events.DynamoDBAttributeValue{
+						"aggregate_id": events.NewStringAttribute(participantID),
+						"type":         events.NewStringAttribute(eventType),
+					},
+				},
+			},
+		},
+	}))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/leaderboard"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestHandleEvent_BatchWithFinishedQuiz_RanksParticipantOnce(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	payloads := []string{
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType),
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+	}
+
+	for _, payload := range payloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	streamHandler := leaderboard.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+
+	err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+		Records: []events.DynamoDBEventRecord{
+			createInsertRecord(participantID, event.StartedQuizTypeName),
+			createInsertRecord(participantID, event.SelectedAnswerTypeName),
+			createInsertRecord(participantID, event.FinishedQuizTypeName),
+			createInsertRecord(participantID, event.FinishedQuizTypeName),
+		},
+	}))
+
+	getLeaderboardResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, leaderboard.NewGetLeaderboardHandler, map[string]string{
+		"courseId": inmemory.CourseIDFrontendDevelopment,
+		"period":   "all-time",
+	})
+
+	totalEntries := json.GetJSONPathValue(getLeaderboardResponse, "$.totalEntries").(float64)
+	rankedParticipantID := json.GetJSONPathValue(getLeaderboardResponse, "$.entries[0].participantId").(string)
+
+	if totalEntries != 1 || rankedParticipantID != participantID {
+		t.Fatalf("expected the participant to be ranked once, got %s", getLeaderboardResponse.Body)
+	}
+}
+
+func createInsertRecord(participantID string, eventType string) events.DynamoDBEventRecord {
+	return events.DynamoDBEventRecord{
+		EventName: string(events.DynamoDBOperationTypeInsert),
+		Change: events.DynamoDBStreamRecord{
+			NewImage: map[string]events.DynamoDBAttributeValue{
+				"aggregate_id": events.NewStringAttribute(participantID),
+				"type":         events.NewStringAttribute(eventType),
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/moderation"
+	"testing"
+)
+
+func TestGetReports_Admin_Returns200WithoutReports(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithQueryParametersForUser(local.AdminParticipantID, moderation.NewGetReportsHandler, map[string]string{}, map[string]string{
+		"status": "open",
+	})
+
+	if getReportsResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getReportsResponse.StatusCode, getReportsResponse.Body)
+	}
+
+	reports := json.GetJSONPathValue(getReportsResponse, "$.reports").([]interface{})
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %s", getReportsResponse.Body)
+	}
+}
+
+func TestGetReports_NoAdmin_Returns403(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(uuid.MustNewRandomAsString(), moderation.NewGetReportsHandler, map[string]string{})
+
+	if getReportsResponse.StatusCode != 403 {
+		t.Fatalf("expected status code 403, got %v", getReportsResponse.StatusCode)
+	}
+}
+
+func TestGetReports_UnknownStatus_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithQueryParametersForUser(local.AdminParticipantID, moderation.NewGetReportsHandler, map[string]string{}, map[string]string{
+		"status": "pending",
+	})
+
+	if getReportsResponse.StatusCode != 400 {
+		t.Fatalf("expected status code 400, got %v", getReportsResponse.StatusCode)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/moderation"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestPostModerationCommand_ResolveQuestionIssue_LinksContentVersion(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	reportID := queueReport(environmentCreator)
+
+	resolvePayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"reportId": "%s", "contentVersion": "5f2c1e9"}, "type": "%s"}`,
+		reportID, command.ResolveQuestionIssueCommandType)
+	resolveResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewPostModerationCommandHandler, resolvePayload)
+
+	if resolveResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", resolveResponse.StatusCode, resolveResponse.Body)
+	}
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithQueryParametersForUser(local.AdminParticipantID, moderation.NewGetReportsHandler, map[string]string{}, map[string]string{
+		"status": "resolved",
+	})
+
+	contentVersion := json.GetJSONPathValue(getReportsResponse, "$.reports[0].contentVersion").(string)
+	if contentVersion != "5f2c1e9" {
+		t.Fatalf("expected the resolved report to link content version 5f2c1e9, got %s", getReportsResponse.Body)
+	}
+
+	resolveAgainResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewPostModerationCommandHandler, resolvePayload)
+	if resolveAgainResponse.StatusCode != 400 {
+		t.Fatalf("expected status code 400 for an already resolved report, got %v", resolveAgainResponse.StatusCode)
+	}
+}
+
+func TestPostModerationCommand_RejectUnknownReport_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	rejectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"reportId": "%s", "reason": "Works as intended"}, "type": "%s"}`,
+		uuid.MustNewRandomAsString(), command.RejectQuestionIssueCommandType)
+	rejectResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewPostModerationCommandHandler, rejectPayload)
+
+	if rejectResponse.StatusCode != 404 {
+		t.Fatalf("expected status code 404, got %v", rejectResponse.StatusCode)
+	}
+}
+
+func TestPostModerationCommand_NoAdmin_Returns403(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	rejectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"reportId": "%s", "reason": "Works as intended"}, "type": "%s"}`,
+		uuid.MustNewRandomAsString(), command.RejectQuestionIssueCommandType)
+	rejectResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(uuid.MustNewRandomAsString(), moderation.NewPostModerationCommandHandler, rejectPayload)
+
+	if rejectResponse.StatusCode != 403 {
+		t.Fatalf("expected status code 403, got %v", rejectResponse.StatusCode)
+	}
+}
+
+// queueReport reports a question issue and queues it like the stream of the events table, returns the report id.
+func queueReport(environmentCreator *local.EnvironmentCreator) string {
+	participantID := uuid.MustNewRandomAsString()
+
+	reportQuestionIssuePayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "category": "wrong-answer", "text": "The second answer is correct as well"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.ReportQuestionIssueCommandType)
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, reportQuestionIssuePayload)
+
+	streamHandler := moderation.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+	err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+		Records: []events.DynamoDBEventRecord{
+			{
+				EventName: string(events.DynamoDBOperationTypeInsert),
+				Change: events.DynamoDBStreamRecord{
+					NewImage: map[string]events.DynamoDBAttributeValue{
+						"aggregate_id": events.NewStringAttribute(participantID),
+						"type":         events.NewStringAttribute(event.QuestionIssueReportedTypeName),
+					},
+				},
+			},
+		},
+	}))
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(local.AdminParticipantID, moderation.NewGetReportsHandler, map[string]string{})
+
+	return json.GetJSONPathValue(getReportsResponse, "$.reports[0].id").(string)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/dynamodb"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/moderation"
+	"testing"
+)
+
+func TestRegrade_DryRunReportsAndRegradeAppliesCorrectedAnswerKey(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := storeAttemptGradedWithOutdatedAnswerKey(environmentCreator)
+
+	dryRunResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewRegradeHandler,
+		fmt.Sprintf(`{"courseId": "%s", "dryRun": true}`, inmemory.CourseIDFrontendDevelopment))
+
+	if dryRunResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", dryRunResponse.StatusCode, dryRunResponse.Body)
+	}
+
+	if json.GetJSONPathValue(dryRunResponse, "$.participants[0].participantId").(string) != participantID {
+		t.Fatalf("expected the dry run to report the participant %s, got %s", participantID, dryRunResponse.Body)
+	}
+
+	if json.GetJSONPathValue(dryRunResponse, "$.participants[0].attempts[0].pass").(bool) != true {
+		t.Fatalf("expected the dry run to report the attempt as passed, got %s", dryRunResponse.Body)
+	}
+
+	regradeResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewRegradeHandler,
+		fmt.Sprintf(`{"courseId": "%s", "dryRun": false}`, inmemory.CourseIDFrontendDevelopment))
+
+	if len(json.GetJSONPathValue(regradeResponse, "$.participants").([]any)) != 1 {
+		t.Fatalf("expected the regrade to change one participant, got %s", regradeResponse.Body)
+	}
+
+	regradedParticipant := err.PanicIfError1(repository(environmentCreator).FindOrCreateByID(participantID))
+	if len(regradedParticipant.GetAwardedBadges()) == 0 {
+		t.Fatalf("expected the regraded pass to award badges")
+	}
+
+	secondDryRunResponse := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewRegradeHandler,
+		fmt.Sprintf(`{"courseId": "%s", "dryRun": true}`, inmemory.CourseIDFrontendDevelopment))
+
+	if len(json.GetJSONPathValue(secondDryRunResponse, "$.participants").([]any)) != 0 {
+		t.Fatalf("expected nothing to regrade after the regrade, got %s", secondDryRunResponse.Body)
+	}
+}
+
+func TestRegrade_MissingDryRun_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	response := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(local.AdminParticipantID, moderation.NewRegradeHandler,
+		fmt.Sprintf(`{"courseId": "%s"}`, inmemory.CourseIDFrontendDevelopment))
+
+	if response.StatusCode != 400 {
+		t.Fatalf("expected status code 400, got %v", response.StatusCode)
+	}
+}
+
+func TestRegrade_NoAdmin_Returns403(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	response := environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(uuid.MustNewRandomAsString(), moderation.NewRegradeHandler,
+		fmt.Sprintf(`{"courseId": "%s", "dryRun": true}`, inmemory.CourseIDFrontendDevelopment))
+
+	if response.StatusCode != 403 {
+		t.Fatalf("expected status code 403, got %v", response.StatusCode)
+	}
+}
+
+// storeAttemptGradedWithOutdatedAnswerKey stores a finished attempt whose correct answer was graded as wrong, like
+// before a correction of the answer key, returns the participant id.
+func storeAttemptGradedWithOutdatedAnswerKey(environmentCreator *local.EnvironmentCreator) string {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
+	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, false))
+	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+	err.PanicIfError(repository(environmentCreator).StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion()))
+
+	return p.GetID()
+}
+
+func repository(environmentCreator *local.EnvironmentCreator) *dynamodb.ParticipantRepository {
+	return dynamodb.NewDynamoDbParticipantRepository(context.Background(), config.Test, environmentCreator.RegistryOverride.DynamoDBClient, dynamodb.NewEventPODeserializer())
+}
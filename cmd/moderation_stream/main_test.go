@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/moderation"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestHandleEvent_RetriedBatch_QueuesReportOnce(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	reportQuestionIssuePayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "category": "typo", "text": "Missing letter in the question"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.ReportQuestionIssueCommandType)
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, reportQuestionIssuePayload)
+
+	streamHandler := moderation.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+
+	for i := 0; i < 2; i++ {
+		err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+			Records: []events.DynamoDBEventRecord{
+				createInsertRecord(participantID, event.QuestionIssueReportedTypeName),
+			},
+		}))
+	}
+
+	getReportsResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(local.AdminParticipantID, moderation.NewGetReportsHandler, map[string]string{})
+
+	reports := json.GetJSONPathValue(getReportsResponse, "$.reports").([]interface{})
+	reportedBy := json.GetJSONPathValue(getReportsResponse, "$.reports[0].participantId").(string)
+
+	if len(reports) != 1 || reportedBy != participantID {
+		t.Fatalf("expected the report to be queued once, got %s", getReportsResponse.Body)
+	}
+}
+
+func createInsertRecord(participantID string, eventType string) events.DynamoDBEventRecord {
+	return events.DynamoDBEventRecord{
+		EventName: string(events.DynamoDBOperationTypeInsert),
+		Change: events.DynamoDBStreamRecord{
+			NewImage: map[string]events.DynamoDBAttributeValue{
+				"aggregate_id": events.NewStringAttribute(participantID),
+				"type":         events.NewStringAttribute(eventType),
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/quiz"
+	"testing"
+)
+
+var startQuizPayload = fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+	inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+
+var selectAnswerPayload = fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+	inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+
+func TestGetActiveAttempt_Returns200WithAnswersSoFar(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, selectAnswerPayload)
+
+	getActiveAttemptResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(
+		participantID,
+		quiz.NewGetParticipantActiveAttemptHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getActiveAttemptResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getActiveAttemptResponse.StatusCode, getActiveAttemptResponse.Body)
+	}
+
+	selectedAnswerIDs := json.GetJSONPathValue(getActiveAttemptResponse, "$.questions[*].selectedAnswerId").([]interface{})
+	if !containsValue(selectedAnswerIDs, inmemory.FirstCorrectAnswerID) {
+		t.Fatalf("expected the selected answer in the active attempt, got %s", getActiveAttemptResponse.Body)
+	}
+
+	nextQuestionID := json.GetJSONPathValue(getActiveAttemptResponse, "$.nextQuestionId").(string)
+	if nextQuestionID == inmemory.FirstQuestionID {
+		t.Fatalf("expected the answered question not to be the next question, got %s", getActiveAttemptResponse.Body)
+	}
+}
+
+func TestGetActiveAttempt_WithoutActiveAttempt_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getActiveAttemptResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		quiz.NewGetParticipantActiveAttemptHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getActiveAttemptResponse.StatusCode != 404 {
+		t.Fatalf("lambda did not return 404 without active attempt, status code: %v, body: %s", getActiveAttemptResponse.StatusCode, getActiveAttemptResponse.Body)
+	}
+}
+
+func containsValue(values []interface{}, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/quiz"
+	"testing"
+)
+
+var startQuizPayload = fmt.Sprintf(`
+{
+   "createdAt":"2023-11-17T04:55:24.059Z",
+   "data": {
+		"quizId":"%s",
+		"requiredQuestionsAnswered": ["%s"]
+	},
+   "type": "%s"
+}
+`, inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+
+func TestGetActiveAttemptQuiz_Returns200(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+
+	getQuizResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(
+		participantID,
+		quiz.NewGetParticipantActiveAttemptQuizHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getQuizResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getQuizResponse.StatusCode, getQuizResponse.Body)
+	}
+
+	quizID := json.GetJSONPathValue(getQuizResponse, "$.id").(string)
+
+	if quizID != inmemory.QuizIDEssentialsOfTheWeb {
+		t.Fatalf("expected quiz %s, got %s", inmemory.QuizIDEssentialsOfTheWeb, quizID)
+	}
+}
+
+func TestGetActiveAttemptQuiz_ReturnsSameOrderForSameAttempt(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+
+	pathParameters := map[string]string{
+		"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+	}
+	firstResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, quiz.NewGetParticipantActiveAttemptQuizHandler, pathParameters)
+	secondResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, quiz.NewGetParticipantActiveAttemptQuizHandler, pathParameters)
+
+	if firstResponse.Body != secondResponse.Body {
+		t.Fatalf("expected the same question order for the same attempt")
+	}
+}
+
+func TestGetActiveAttemptQuiz_WithoutActiveAttempt_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getQuizResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		quiz.NewGetParticipantActiveAttemptQuizHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getQuizResponse.StatusCode != 404 {
+		t.Fatalf("lambda did not return 404 without active attempt, status code: %v, body: %s", getQuizResponse.StatusCode, getQuizResponse.Body)
+	}
+}
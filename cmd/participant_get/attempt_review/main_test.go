@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/quiz"
+	"testing"
+)
+
+var startQuizPayload = fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+	inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+
+var selectAnswerPayload = fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+	inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+
+func TestGetAttemptReview_OngoingAttempt_RevealsOnlyAnsweredQuestions(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, selectAnswerPayload)
+
+	getReviewResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, quiz.NewGetAttemptReviewHandler, map[string]string{
+		"quizId":    inmemory.QuizIDEssentialsOfTheWeb,
+		"attemptId": "latest",
+	})
+
+	if getReviewResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getReviewResponse.StatusCode, getReviewResponse.Body)
+	}
+
+	timeline := json.GetJSONPathValue(getReviewResponse, "$.timeline").([]any)
+	if len(timeline) != 1 || timeline[0].(map[string]any)["answerId"] != inmemory.FirstCorrectAnswerID {
+		t.Fatalf("expected the selected answer in the timeline, got %v", timeline)
+	}
+
+	questions := json.GetJSONPathValue(getReviewResponse, "$.questions").([]any)
+	for _, generalQuestion := range questions {
+		question := generalQuestion.(map[string]any)
+		answered := question["id"] == inmemory.FirstQuestionID
+
+		if question["revealed"] != answered {
+			t.Fatalf("expected only the answered question to be revealed, got %v", question)
+		}
+
+		answers := question["answers"].([]any)
+		_, hasAnswerKey := answers[0].(map[string]any)["isCorrect"]
+		if hasAnswerKey != answered {
+			t.Fatalf("expected the answer key only for the answered question, got %v", question)
+		}
+	}
+}
+
+func TestGetAttemptReview_UnknownAttempt_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+
+	getReviewResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, quiz.NewGetAttemptReviewHandler, map[string]string{
+		"quizId":    inmemory.QuizIDEssentialsOfTheWeb,
+		"attemptId": "0",
+	})
+
+	if getReviewResponse.StatusCode != 404 {
+		t.Fatalf("expected status code 404, got %v", getReviewResponse.StatusCode)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/badge"
+	"testing"
+)
+
+var passQuizPayloads = []string{
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+}
+
+func TestGetBadges_Returns200WithoutBadges(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getBadgesResponse := environmentCreator.ExecuteLambdaHandler(badge.NewGetParticipantBadgesHandler)
+
+	if getBadgesResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getBadgesResponse.StatusCode, getBadgesResponse.Body)
+	}
+
+	badges := json.GetJSONPathValue(getBadgesResponse, "$.badges").([]interface{})
+
+	if len(badges) != 0 {
+		t.Fatalf("expected no badges for a new participant")
+	}
+}
+
+func TestGetBadges_PassedQuiz_ReturnsAwardedBadges(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	for _, payload := range passQuizPayloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	getBadgesResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, badge.NewGetParticipantBadgesHandler, map[string]string{})
+
+	if getBadgesResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getBadgesResponse.StatusCode, getBadgesResponse.Body)
+	}
+
+	firstBadgeID := json.GetJSONPathValue(getBadgesResponse, "$.badges[0].badgeId").(string)
+
+	if firstBadgeID != "first-quiz-passed" {
+		t.Fatalf("expected the first quiz passed badge, got %s", firstBadgeID)
+	}
+}
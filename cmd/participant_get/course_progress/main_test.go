@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/progress"
+	"testing"
+)
+
+var startQuizPayload = fmt.Sprintf(`
+{
+   "createdAt":"2023-11-17T04:55:24.059Z",
+   "data": {
+		"quizId":"%s",
+		"requiredQuestionsAnswered": ["%s"]
+	},
+   "type": "%s"
+}
+`, inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+
+func TestGetCourseProgress_Returns200(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+
+	getProgressResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(
+		participantID,
+		progress.NewGetParticipantCourseProgressHandler,
+		map[string]string{
+			"courseId": inmemory.CourseIDFrontendDevelopment,
+		})
+
+	if getProgressResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getProgressResponse.StatusCode, getProgressResponse.Body)
+	}
+
+	nextRecommendedQuizID := json.GetJSONPathValue(getProgressResponse, "$.nextRecommendedQuizId").(string)
+
+	if nextRecommendedQuizID != inmemory.QuizIDEssentialsOfTheWeb {
+		t.Fatalf("expected the started quiz to be recommended, got %s", nextRecommendedQuizID)
+	}
+}
+
+func TestGetCourseProgress_UnknownCourse_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getProgressResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		progress.NewGetParticipantCourseProgressHandler,
+		map[string]string{
+			"courseId": "unknown",
+		})
+
+	if getProgressResponse.StatusCode != 404 {
+		t.Fatalf("lambda did not return 404 for unknown course, status code: %v, body: %s", getProgressResponse.StatusCode, getProgressResponse.Body)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant/review"
+	"testing"
+)
+
+func TestGetDueReviews_Returns200WithoutReviews(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getDueReviewsResponse := environmentCreator.ExecuteLambdaHandler(review.NewGetParticipantDueReviewsHandler)
+
+	if getDueReviewsResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getDueReviewsResponse.StatusCode, getDueReviewsResponse.Body)
+	}
+
+	dueReviews := json.GetJSONPathValue(getDueReviewsResponse, "$.dueReviews").([]interface{})
+
+	if len(dueReviews) != 0 {
+		t.Fatalf("expected no due reviews for a new participant")
+	}
+}
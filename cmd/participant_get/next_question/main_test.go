@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/quiz"
+	"testing"
+)
+
+var startQuizPayload = fmt.Sprintf(`
+{
+   "createdAt":"2023-11-17T04:55:24.059Z",
+   "data": {
+		"quizId":"%s",
+		"requiredQuestionsAnswered": ["%s"]
+	},
+   "type": "%s"
+}
+`, inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+
+func TestGetNextQuestion_WithoutAttempt_Returns404(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getNextQuestionResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParameters(
+		quiz.NewGetParticipantNextQuestionHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getNextQuestionResponse.StatusCode != 404 {
+		t.Fatalf("lambda did not return 404 without attempt, status code: %v, body: %s", getNextQuestionResponse.StatusCode, getNextQuestionResponse.Body)
+	}
+}
+
+func TestGetNextQuestion_ForQuizThatIsNotAdaptive_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, startQuizPayload)
+
+	getNextQuestionResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(
+		participantID,
+		quiz.NewGetParticipantNextQuestionHandler,
+		map[string]string{
+			"quizId": inmemory.QuizIDEssentialsOfTheWeb,
+		})
+
+	if getNextQuestionResponse.StatusCode != 400 {
+		t.Fatalf("lambda did not return 400 for a quiz that is not adaptive, status code: %v, body: %s", getNextQuestionResponse.StatusCode, getNextQuestionResponse.Body)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/clock"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/participant/stats"
+	"testing"
+	"time"
+)
+
+var setTimezonePayload = fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"timezone": "Europe/Berlin"}, "type": "%s"}`, command.SetTimezoneCommandType)
+
+var passQuizPayloads = []string{
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+}
+
+func TestGetStats_Returns200WithoutActivity(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	getStatsResponse := environmentCreator.ExecuteLambdaHandler(stats.NewGetParticipantStatsHandler)
+
+	if getStatsResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getStatsResponse.StatusCode, getStatsResponse.Body)
+	}
+
+	totalXP := json.GetJSONPathValue(getStatsResponse, "$.totalXp").(float64)
+	if totalXP != 0 {
+		t.Fatalf("expected no XP for a new participant, got %v", totalXP)
+	}
+}
+
+func TestGetStats_PassedQuiz_ReturnsXPAndStreakInTimezone(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, setTimezonePayload)
+	for _, payload := range passQuizPayloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(participantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	environmentCreator.RegistryOverride.Clock = clock.NewFixedClock(time.Now().AddDate(0, 0, 1))
+
+	getStatsResponse := environmentCreator.ExecuteLambdaHandlerGETWithPathParametersForUser(participantID, stats.NewGetParticipantStatsHandler, map[string]string{})
+
+	if getStatsResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, body: %s", getStatsResponse.StatusCode, getStatsResponse.Body)
+	}
+
+	timezone := json.GetJSONPathValue(getStatsResponse, "$.timezone").(string)
+	if timezone != "Europe/Berlin" {
+		t.Fatalf("expected the stored timezone, got %s", timezone)
+	}
+
+	totalXP := json.GetJSONPathValue(getStatsResponse, "$.totalXp").(float64)
+	xpToday := json.GetJSONPathValue(getStatsResponse, "$.xpToday").(float64)
+	if totalXP == 0 || xpToday != 0 {
+		t.Fatalf("expected XP earned yesterday, got %v in total and %v today", totalXP, xpToday)
+	}
+
+	currentStreakDays := json.GetJSONPathValue(getStatsResponse, "$.currentStreakDays").(float64)
+	if currentStreakDays != 1 {
+		t.Fatalf("expected a streak of 1 day, got %v", currentStreakDays)
+	}
+}
@@ -3,10 +3,14 @@ package main_test
 import (
 	"fmt"
 	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/infrastructure/clock"
 	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/inmemory"
 	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
 	"learn-to-code/internal/interfaces/lambda/participant"
 	"testing"
+	"time"
 )
 
 var startQuizCommand = fmt.Sprintf(`
@@ -65,6 +69,255 @@ var eventBodyEmptyStartQuiz = fmt.Sprintf(`
 }
 `, command.StartQuizCommandType)
 
+var reviewQuestionPayload = fmt.Sprintf(`
+{
+   "createdAt":"2023-11-17T04:55:24.059Z",
+   "data": {
+		"quizId":"%s",
+		"questionId":"%s",
+		"answerId":"%s",
+		"quality": 0
+	},
+   "type": "%s"
+}
+`, inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, command.ReviewQuestionCommandType)
+
+var answerQuestionWrongPayloads = []string{
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, command.SelectAnswerCommandType),
+	fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+}
+
+func TestPutParticipantLambda_ReviewQuestion_Returns200(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	for _, payload := range answerQuestionWrongPayloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	environmentCreator.RegistryOverride.Clock = clock.NewFixedClock(time.Now().AddDate(0, 0, 1))
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, reviewQuestionPayload)
+
+	if handlerResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_ReviewQuestionNotDue_Returns409(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	for _, payload := range answerQuestionWrongPayloads {
+		environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, reviewQuestionPayload)
+
+	if handlerResponse.StatusCode != 409 {
+		t.Fatalf("lambda return code is not 409 although the review is not due yet: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+var rateQuestionPayload = fmt.Sprintf(`
+{
+   "createdAt":"2023-11-17T04:55:24.059Z",
+   "data": {
+		"quizId":"%s",
+		"questionId":"%s",
+		"stars": 4
+	},
+   "type": "%s"
+}
+`, inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.RateQuestionCommandType)
+
+func TestPutParticipantLambda_RateQuestion_Returns200(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, rateQuestionPayload)
+
+	if handlerResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_RateQuestionWithInvalidStars_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	invalidRatingPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "stars": 6}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.RateQuestionCommandType)
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, invalidRatingPayload)
+
+	if handlerResponse.StatusCode != 400 {
+		t.Fatalf("lambda return code is not 400 although the rating is invalid: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_ReviewUnknownQuestion_Returns400(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	unknownQuestionPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "unknown", "answerId": "%s", "quality": 3}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstAnswerID, command.ReviewQuestionCommandType)
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, unknownQuestionPayload)
+
+	if handlerResponse.StatusCode != 400 {
+		t.Fatalf("lambda return code is not 400 although the question is unknown: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_SelectAnswer_ReturnsAnswerFeedback(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	selectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+	finishPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType)
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, startPayload)
+	selectResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, selectPayload)
+
+	if json.GetJSONPathValue(selectResponse, "$.answerFeedback.isCorrect").(bool) != true {
+		t.Fatalf("expected feedback for the correct answer, got %s", selectResponse.Body)
+	}
+
+	if json.GetJSONPathValue(selectResponse, "$.attemptProgress.answeredQuestions").(float64) != 1 {
+		t.Fatalf("expected one answered question in the progress, got %s", selectResponse.Body)
+	}
+
+	finishResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, finishPayload)
+
+	if json.GetJSONPathValue(finishResponse, "$.attemptResult.pass").(bool) != true {
+		t.Fatalf("expected the passed attempt result, got %s", finishResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_PracticeAttempt_IsNotGraded(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"], "mode": "practice"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	selectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+	finishPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType)
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, startPayload)
+	selectResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, selectPayload)
+
+	if json.GetJSONPathValue(selectResponse, "$.attemptProgress.practice").(bool) != true {
+		t.Fatalf("expected the progress of a practice attempt, got %s", selectResponse.Body)
+	}
+
+	finishResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, finishPayload)
+
+	if json.GetJSONPathValue(finishResponse, "$.attemptResult.graded").(bool) != false || json.GetJSONPathValue(finishResponse, "$.attemptResult.pass").(bool) != true {
+		t.Fatalf("expected the ungraded result of the passed practice attempt, got %s", finishResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_RetakeWrongAnsweredQuestions_Returns200(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	selectWrongPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, command.SelectAnswerCommandType)
+	finishPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType)
+	retakePayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "retakeAttemptId": 1}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.StartQuizCommandType)
+
+	for _, payload := range []string{startPayload, selectWrongPayload, finishPayload} {
+		environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	retakeResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, retakePayload)
+
+	if retakeResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, %v", retakeResponse.StatusCode, retakeResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_RetakeWithoutWrongAnswers_Returns409(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	selectCorrectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+	finishPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType)
+	retakePayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "retakeAttemptId": 1}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, command.StartQuizCommandType)
+
+	for _, payload := range []string{startPayload, selectCorrectPayload, finishPayload} {
+		environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	retakeResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, retakePayload)
+
+	if retakeResponse.StatusCode != 409 {
+		t.Fatalf("lambda return code is not 409 although the attempt has no wrong answers: %v, %v", retakeResponse.StatusCode, retakeResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_RequestHint_ReturnsHint(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	requestHintPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.RequestHintCommandType)
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, startPayload)
+	hintResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, requestHintPayload)
+
+	if hintResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, %v", hintResponse.StatusCode, hintResponse.Body)
+	}
+
+	if json.GetJSONPathValue(hintResponse, "$.hint.index").(float64) != 0 || json.GetJSONPathValue(hintResponse, "$.hint.text") == "" {
+		t.Fatalf("expected the first hint, got %s", hintResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_SelectAnswerWithConfidence_ReturnsCertaintyMark(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType)
+	selectPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s", "confidence": "high"}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, command.SelectAnswerCommandType)
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, startPayload)
+	selectResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, selectPayload)
+
+	if selectResponse.StatusCode != 200 {
+		t.Fatalf("lambda did not succeed, status code: %v, %v", selectResponse.StatusCode, selectResponse.Body)
+	}
+
+	if json.GetJSONPathValue(selectResponse, "$.answerFeedback.certaintyMark").(float64) != 3 {
+		t.Fatalf("expected a certainty mark of 3 for a confident correct answer, got %s", selectResponse.Body)
+	}
+}
+
 func TestPutParticipantLambda_Returns200(t *testing.T) {
 	environmentCreator := local.NewEnvironmentCreator(config.Test)
 	defer environmentCreator.Terminate()
@@ -146,3 +399,46 @@ func TestPutParticipantLambda_InvalidStartQuiz_Returns400(t *testing.T) {
 	}
 
 }
+
+func TestPutParticipantLambda_StartLockedStep_Returns403(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	startLockedQuizPayload := fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": []}, "type": "%s"}`,
+		inmemory.QuizIDJavaScriptAdvanced, command.StartQuizCommandType)
+
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(participant.NewPostParticipantCommandHandler, startLockedQuizPayload)
+
+	if handlerResponse.StatusCode != 403 {
+		t.Fatalf("lambda return code is not 403 although the step is locked: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_StartQuizTwice_Returns409(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	handler := participant.NewPostParticipantCommandHandler
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(handler, startQuizCommand)
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(handler, startQuizCommand)
+
+	if handlerResponse.StatusCode != 409 {
+		t.Fatalf("lambda return code is not 409 although the quiz is already started: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
+
+func TestPutParticipantLambda_SelectAnswerTwiceAfterFeedback_Returns409(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	handler := participant.NewPostParticipantCommandHandler
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(handler, startQuizCommand)
+	environmentCreator.ExecuteLambdaHandlerWithPostBody(handler, eventBody2)
+	handlerResponse := environmentCreator.ExecuteLambdaHandlerWithPostBody(handler, eventBody2)
+
+	if handlerResponse.StatusCode != 409 {
+		t.Fatalf("lambda return code is not 409 although the answer was already revealed: %v, %v", handlerResponse.StatusCode, handlerResponse.Body)
+	}
+}
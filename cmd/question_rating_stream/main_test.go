@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/course"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/questionrating"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestHandleEvent_QuestionRated_AggregatesRatingsInCourse(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	firstParticipantID := uuid.MustNewRandomAsString()
+	secondParticipantID := uuid.MustNewRandomAsString()
+
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(firstParticipantID, participant.NewPostParticipantCommandHandler, createRateQuestionPayload(2))
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(firstParticipantID, participant.NewPostParticipantCommandHandler, createRateQuestionPayload(4))
+	environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(secondParticipantID, participant.NewPostParticipantCommandHandler, createRateQuestionPayload(5))
+
+	streamHandler := questionrating.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+
+	err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+		Records: []events.DynamoDBEventRecord{
+			createInsertRecord(firstParticipantID, event.QuestionRatedTypeName),
+			createInsertRecord(firstParticipantID, event.QuestionRatedTypeName),
+			createInsertRecord(secondParticipantID, event.QuestionRatedTypeName),
+		},
+	}))
+
+	getCourseResponse := environmentCreator.ExecuteLambdaHandler(course.NewLambdaHandler)
+
+	rating := json.GetJSONPathValue(getCourseResponse, "$.steps[0].quizzes[0].questions[0].rating").(float64)
+	ratingCount := json.GetJSONPathValue(getCourseResponse, "$.steps[0].quizzes[0].questions[0].ratingCount").(float64)
+
+	if rating != 4.5 || ratingCount != 2 {
+		t.Fatalf("expected rating 4.5 from 2 participants, got %v from %v", rating, ratingCount)
+	}
+}
+
+func createRateQuestionPayload(stars int) string {
+	return fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "stars": %d}, "type": "%s"}`,
+		inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, stars, command.RateQuestionCommandType)
+}
+
+func createInsertRecord(participantID string, eventType string) events.DynamoDBEventRecord {
+	return events.DynamoDBEventRecord{
+		EventName: string(events.DynamoDBOperationTypeInsert),
+		Change: events.DynamoDBStreamRecord{
+			NewImage: map[string]events.DynamoDBAttributeValue{
+				"aggregate_id": events.NewStringAttribute(participantID),
+				"type":         events.NewStringAttribute(eventType),
+			},
+		},
+	}
+}
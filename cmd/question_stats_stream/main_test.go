@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/local"
+	"learn-to-code/internal/infrastructure/testing/json"
+	"learn-to-code/internal/interfaces/lambda/course"
+	"learn-to-code/internal/interfaces/lambda/participant"
+	"learn-to-code/internal/interfaces/lambda/questionstats"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestHandleEvent_FinishedQuiz_AggregatesQuestionStatsInCourse(t *testing.T) {
+	environmentCreator := local.NewEnvironmentCreator(config.Test)
+	defer environmentCreator.Terminate()
+
+	firstParticipantID := uuid.MustNewRandomAsString()
+	secondParticipantID := uuid.MustNewRandomAsString()
+
+	for _, payload := range createAttemptPayloads(inmemory.FirstCorrectAnswerID) {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(firstParticipantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+	for _, payload := range createAttemptPayloads(inmemory.FirstAnswerID) {
+		environmentCreator.ExecuteLambdaHandlerWithPostBodyForUser(secondParticipantID, participant.NewPostParticipantCommandHandler, payload)
+	}
+
+	streamHandler := questionstats.NewStreamHandler(environmentCreator.Cfg, environmentCreator.RegistryOverride)
+
+	err.PanicIfError(streamHandler.HandleEvent(context.Background(), events.DynamoDBEvent{
+		Records: []events.DynamoDBEventRecord{
+			createInsertRecord(firstParticipantID, event.QuestionViewedTypeName, 2),
+			createInsertRecord(firstParticipantID, event.FinishedQuizTypeName, 4),
+			createInsertRecord(secondParticipantID, event.FinishedQuizTypeName, 4),
+		},
+	}))
+
+	getCourseResponse := environmentCreator.ExecuteLambdaHandler(course.NewLambdaHandler)
+
+	answerCount := json.GetJSONPathValue(getCourseResponse, "$.steps[0].quizzes[0].questions[0].answerCount").(float64)
+	correctRatio := json.GetJSONPathValue(getCourseResponse, "$.steps[0].quizzes[0].questions[0].correctRatio").(float64)
+
+	if answerCount != 2 || correctRatio != 0.5 {
+		t.Fatalf("expected a correct ratio of 0.5 from 2 answers, got %v from %v", correctRatio, answerCount)
+	}
+}
+
+func createAttemptPayloads(answerID string) []string {
+	return []string{
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "requiredQuestionsAnswered": ["%s"]}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.StartQuizCommandType),
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s"}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, command.ViewQuestionCommandType),
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s", "questionId": "%s", "answerId": "%s"}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, answerID, command.SelectAnswerCommandType),
+		fmt.Sprintf(`{"createdAt": "2023-11-17T04:55:24.059Z", "data": {"quizId": "%s"}, "type": "%s"}`,
+			inmemory.QuizIDEssentialsOfTheWeb, command.FinishQuizCommandType),
+	}
+}
+
+func createInsertRecord(participantID string, eventType string, version int) events.DynamoDBEventRecord {
+	return events.DynamoDBEventRecord{
+		EventName: string(events.DynamoDBOperationTypeInsert),
+		Change: events.DynamoDBStreamRecord{
+			NewImage: map[string]events.DynamoDBAttributeValue{
+				"aggregate_id": events.NewStringAttribute(participantID),
+				"type":         events.NewStringAttribute(eventType),
+				"version":      events.NewNumberAttribute(strconv.Itoa(version)),
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+)
+
+func NewCertificateApplicationService(participantRepository participant.Repository, courseRepository course.Repository, certificateSigner participant.CertificateSigner) *CertificateApplicationService {
+	return &CertificateApplicationService{
+		participantRepository: participantRepository,
+		courseRepository:      courseRepository,
+		certificateSigner:     certificateSigner,
+	}
+}
+
+type CertificateApplicationService struct {
+	participantRepository participant.Repository
+	courseRepository      course.Repository
+	certificateSigner     participant.CertificateSigner
+}
+
+// VerifyCertificate returns the certificate and its course if the certificate was issued by this system.
+func (as *CertificateApplicationService) VerifyCertificate(certificateID string) (participant.Certificate, course.Course, error) {
+	participantID, err := participant.ParseCertificateID(certificateID)
+	if err != nil {
+		return participant.Certificate{}, course.Course{}, err
+	}
+
+	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	if err != nil {
+		return participant.Certificate{}, course.Course{}, err
+	}
+
+	certificate, err := p.GetCertificate(certificateID)
+	if err != nil {
+		return participant.Certificate{}, course.Course{}, err
+	}
+
+	if !certificate.IsValid(as.certificateSigner) {
+		return participant.Certificate{}, course.Course{}, fmt.Errorf("certificate %v: %w", certificateID, participant.ErrInvalidCertificateSignature)
+	}
+
+	c, err := as.courseRepository.FindByID(certificate.CourseID)
+	if err != nil {
+		return participant.Certificate{}, course.Course{}, err
+	}
+
+	return certificate, c, nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/leaderboardentries"
+	"learn-to-code/internal/infrastructure/clock"
+)
+
+type LeaderboardApplicationService struct {
+	participantRepository participant.Repository
+	courseRepository      course.Repository
+	leaderboardRepository leaderboard.Repository
+	clock                 clock.Clock
+}
+
+func NewLeaderboardApplicationService(participantRepository participant.Repository, courseRepository course.Repository, leaderboardRepository leaderboard.Repository, clock clock.Clock) *LeaderboardApplicationService {
+	return &LeaderboardApplicationService{
+		participantRepository: participantRepository,
+		courseRepository:      courseRepository,
+		leaderboardRepository: leaderboardRepository,
+		clock:                 clock,
+	}
+}
+
+// UpdateParticipantEntries recalculates the leaderboard entries of the participant from its events and removes them
+// if the participant opted out. Processing the same participant again is safe, e.g. for retried stream batches.
+func (as *LeaderboardApplicationService) UpdateParticipantEntries(participantID string) error {
+	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	if err != nil {
+		return err
+	}
+
+	courses, err := findAttemptedCourses(as.courseRepository, &p)
+	if err != nil {
+		return err
+	}
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, courses)
+
+	if p.IsLeaderboardOptOut() {
+		return as.leaderboardRepository.DeleteEntries(entries)
+	}
+
+	return as.leaderboardRepository.StoreEntries(entries)
+}
+
+// GetLeaderboard returns a page of the ranking of the course, the period is either all-time or weekly.
+func (as *LeaderboardApplicationService) GetLeaderboard(courseID string, period string, metric string, page int, pageSize int) (leaderboard.Ranking, error) {
+	_, err := as.courseRepository.FindByID(courseID)
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	resolvedPeriod, err := leaderboard.ParsePeriod(period, as.clock.Now())
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	resolvedMetric, err := leaderboard.ParseMetric(metric)
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	entries, err := as.leaderboardRepository.FindEntries(courseID, resolvedPeriod)
+	if err != nil {
+		return leaderboard.Ranking{}, err
+	}
+
+	return leaderboard.NewRanking(courseID, resolvedPeriod, resolvedMetric, entries, page, pageSize), nil
+}
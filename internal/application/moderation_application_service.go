@@ -0,0 +1,73 @@
+package application
+
+import (
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/domain/quiz/participant"
+)
+
+type ModerationApplicationService struct {
+	participantRepository     participant.Repository
+	moderationQueueRepository moderation.Repository
+	moderationCommandApplier  *command.ModerationCommandApplier
+}
+
+func NewModerationApplicationService(participantRepository participant.Repository, moderationQueueRepository moderation.Repository, moderationCommandApplier *command.ModerationCommandApplier) *ModerationApplicationService {
+	return &ModerationApplicationService{
+		participantRepository:     participantRepository,
+		moderationQueueRepository: moderationQueueRepository,
+		moderationCommandApplier:  moderationCommandApplier,
+	}
+}
+
+// QueueParticipantReports adds the question issues reported by the participant to the moderation queue. Queued
+// reports are skipped, so processing the same participant again is safe, e.g. for retried stream batches.
+func (as *ModerationApplicationService) QueueParticipantReports(participantID string) error {
+	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	if err != nil {
+		return err
+	}
+
+	q, err := as.moderationQueueRepository.FindOrCreate()
+	if err != nil {
+		return err
+	}
+
+	for _, report := range p.GetQuestionIssueReports() {
+		_, err = q.Enqueue(report)
+		if err != nil {
+			return err
+		}
+	}
+
+	return as.moderationQueueRepository.StoreEvents(q.GetNewEventsAndUpdatePersistedVersion())
+}
+
+// GetReports returns the reports of the moderation queue with the status, the oldest report first.
+func (as *ModerationApplicationService) GetReports(status string) ([]moderation.Report, error) {
+	parsedStatus, err := moderation.ParseStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := as.moderationQueueRepository.FindOrCreate()
+	if err != nil {
+		return nil, err
+	}
+
+	return q.GetReports(parsedStatus), nil
+}
+
+func (as *ModerationApplicationService) ProcessCommand(commandDomainObject command.Command) error {
+	q, err := as.moderationQueueRepository.FindOrCreate()
+	if err != nil {
+		return err
+	}
+
+	q, err = as.moderationCommandApplier.ApplyCommand(commandDomainObject, q)
+	if err != nil {
+		return err
+	}
+
+	return as.moderationQueueRepository.StoreEvents(q.GetNewEventsAndUpdatePersistedVersion())
+}
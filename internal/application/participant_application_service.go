@@ -1,26 +1,70 @@
 package application
 
 import (
+	"errors"
 	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/internal/domain/quiz/course"
 	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/projection"
+	"learn-to-code/internal/domain/quiz/participant/projection/activeattempt"
+	"learn-to-code/internal/domain/quiz/participant/projection/attemptreview"
+	"learn-to-code/internal/domain/quiz/participant/projection/courseprogress"
+	"learn-to-code/internal/domain/quiz/participant/projection/participantstats"
 	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+	"learn-to-code/internal/domain/quiz/participant/projection/reviewqueue"
+	"learn-to-code/internal/infrastructure/clock"
 )
 
 type ParticipantApplicationService struct {
 	participantRepository  participant.Repository
+	courseRepository       course.Repository
+	badgeRuleRepository    badge.Repository
 	startQuizToEventMapper *command.ParticipantCommandApplier
+	clock                  clock.Clock
 }
 
-func NewPartcipantApplicationService(participantRepository participant.Repository, participantCommandApplier *command.ParticipantCommandApplier) *ParticipantApplicationService {
+func NewPartcipantApplicationService(participantRepository participant.Repository, courseRepository course.Repository, badgeRuleRepository badge.Repository, participantCommandApplier *command.ParticipantCommandApplier, clock clock.Clock) *ParticipantApplicationService {
 	return &ParticipantApplicationService{
 		participantRepository:  participantRepository,
+		courseRepository:       courseRepository,
+		badgeRuleRepository:    badgeRuleRepository,
 		startQuizToEventMapper: participantCommandApplier,
+		clock:                  clock,
 	}
 }
 
-func (as *ParticipantApplicationService) GetStartedQuizCount(participantID string) (int, error) {
+// findParticipant loads the participant with the clock of the service and lazily times out every expired quiz attempt.
+func (as *ParticipantApplicationService) findParticipant(participantID string) (participant.Participant, error) {
 	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	if err != nil {
+		return participant.Participant{}, err
+	}
+
+	p.UseClock(as.clock)
+
+	timedOutQuizIDs, err := p.TimeOutExpiredQuizzes(as.courseRepository)
+	if err != nil {
+		return participant.Participant{}, err
+	}
+
+	err = as.startQuizToEventMapper.ApplyQuizTimeOuts(&p, timedOutQuizIDs)
+	if err != nil {
+		return participant.Participant{}, err
+	}
+
+	if len(timedOutQuizIDs) > 0 {
+		err = as.participantRepository.StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion())
+		if err != nil {
+			return participant.Participant{}, err
+		}
+	}
+
+	return p, nil
+}
+
+func (as *ParticipantApplicationService) GetStartedQuizCount(participantID string) (int, error) {
+	p, err := as.findParticipant(participantID)
 	if err != nil {
 		return 0, err
 	}
@@ -28,36 +72,207 @@ func (as *ParticipantApplicationService) GetStartedQuizCount(participantID strin
 	return p.GetStartedQuizCount(), nil
 }
 
-func (as *ParticipantApplicationService) ProcessCommand(commandDomainObject command.Command, participantID string) error {
+// ProcessCommand applies the command to the participant and returns the feedback to the command.
+func (as *ParticipantApplicationService) ProcessCommand(commandDomainObject command.Command, participantID string) (command.Result, error) {
 
-	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	p, err := as.findParticipant(participantID)
 	if err != nil {
-		return err
+		return command.Result{}, err
 	}
 
-	p, err = as.startQuizToEventMapper.ApplyCommand(commandDomainObject, p)
+	p, err = as.startQuizToEventMapper.ApplyCommand(commandDomainObject, p, as.clock.Now())
 	if err != nil {
-		return err
+		return command.Result{}, err
 	}
 
-	appendEventErr := as.participantRepository.StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion())
+	err = as.participantRepository.StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion())
+	if err != nil {
+		return command.Result{}, err
+	}
 
-	return appendEventErr
+	return as.startQuizToEventMapper.CreateResult(commandDomainObject, p, as.clock.Now())
 }
 
 func (as *ParticipantApplicationService) GetQuizzes(participantID string) (projection.QuizOverview, error) {
-	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return projection.QuizOverview{}, err
+	}
+
+	now := as.clock.Now()
+
+	quizOverview, err := projection.NewQuizOverview(p, now)
 	if err != nil {
 		return projection.QuizOverview{}, err
 	}
 
-	quizOverview, err := projection.NewQuizOverview(p)
+	for _, quizID := range p.GetAttemptedQuizIDs() {
+		settings, err := as.startQuizToEventMapper.FindAttemptSettings(quizID)
+		if err != nil {
+			return projection.QuizOverview{}, err
+		}
+
+		quizOverview.NextAttempts[quizID] = p.GetNextAttempt(quizID, settings, now)
+	}
+
+	return quizOverview, nil
+}
+
+// GetActiveAttemptQuiz returns the quiz of the ongoing attempt with the drawn questions and answers in the order of the attempt.
+func (as *ParticipantApplicationService) GetActiveAttemptQuiz(participantID string, quizID string) (course.StepQuiz, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return course.StepQuiz{}, err
+	}
+
+	attemptID, err := p.GetActiveAttemptID(quizID)
+	if err != nil {
+		return course.StepQuiz{}, err
+	}
+
+	stepQuiz, err := as.courseRepository.FindQuizByID(quizID)
+	if err != nil {
+		return course.StepQuiz{}, err
+	}
+
+	return p.GetAttemptQuiz(stepQuiz, attemptID)
+}
+
+// GetActiveAttempt returns the state of the ongoing attempt of the quiz to resume it, that is the questions in the order
+// of the attempt with the answers and hints so far, the next question and the remaining time.
+func (as *ParticipantApplicationService) GetActiveAttempt(participantID string, quizID string) (activeattempt.ActiveAttempt, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return activeattempt.ActiveAttempt{}, err
+	}
+
+	stepQuiz, err := as.courseRepository.FindQuizByID(quizID)
+	if err != nil {
+		return activeattempt.ActiveAttempt{}, err
+	}
+
+	return activeattempt.NewActiveAttempt(p, stepQuiz, as.clock.Now())
+}
+
+// GetNextAdaptiveQuestion returns the next question of the adaptive attempt chosen by the answers so far.
+func (as *ParticipantApplicationService) GetNextAdaptiveQuestion(participantID string, quizID string) (participant.NextQuestion, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return participant.NextQuestion{}, err
+	}
+
+	stepQuiz, err := as.courseRepository.FindQuizByID(quizID)
+	if err != nil {
+		return participant.NextQuestion{}, err
+	}
+
+	return p.GetNextAdaptiveQuestion(stepQuiz)
+}
+
+// GetCourse returns the course with the steps locked whose prerequisites the participant has not met yet.
+func (as *ParticipantApplicationService) GetCourse(participantID string, courseID string) (course.Course, error) {
+	c, err := as.courseRepository.FindByID(courseID)
+	if err != nil {
+		return course.Course{}, err
+	}
+
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return course.Course{}, err
+	}
 
-	return quizOverview, err
+	return c.WithLockedSteps(p.GetPassedQuizIDs()), nil
+}
+
+func (as *ParticipantApplicationService) GetCourseProgress(participantID string, courseID string) (courseprogress.CourseProgress, error) {
+	c, err := as.courseRepository.FindByID(courseID)
+	if err != nil {
+		return courseprogress.CourseProgress{}, err
+	}
+
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return courseprogress.CourseProgress{}, err
+	}
+
+	return courseprogress.NewCourseProgress(p, c, as.clock.Now())
+}
+
+// GetDueReviews returns the questions of the review queue that are due now.
+func (as *ParticipantApplicationService) GetDueReviews(participantID string) ([]reviewqueue.ReviewItem, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reviewqueue.NewReviewQueue(p).GetDueReviews(as.clock.Now()), nil
+}
+
+// GetBadges returns all awarded badges, the badges are awarded when the attempts of the quizzes finish.
+func (as *ParticipantApplicationService) GetBadges(participantID string) ([]badge.AwardedBadge, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	awardedBadges := p.GetAwardedBadges()
+	for i, awardedBadge := range awardedBadges {
+		rules, err := as.badgeRuleRepository.FindRulesByCourseID(awardedBadge.CourseID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules {
+			if rule.BadgeID == awardedBadge.BadgeID {
+				awardedBadges[i].Name = rule.Name
+				awardedBadges[i].Description = rule.Description
+			}
+		}
+	}
+
+	return awardedBadges, nil
+}
+
+// GetStats returns the XP and streaks of the participant.
+func (as *ParticipantApplicationService) GetStats(participantID string) (participantstats.ParticipantStats, error) {
+	p, err := as.findParticipant(participantID)
+	if err != nil {
+		return participantstats.ParticipantStats{}, err
+	}
+
+	courses, err := findAttemptedCourses(as.courseRepository, &p)
+	if err != nil {
+		return participantstats.ParticipantStats{}, err
+	}
+
+	return participantstats.NewParticipantStats(p, courses, as.clock.Now()), nil
+}
+
+// findAttemptedCourses returns every course the participant attempted a quiz of.
+func findAttemptedCourses(courseRepository course.Repository, p *participant.Participant) ([]course.Course, error) {
+	var courses []course.Course
+	courseIDs := map[string]bool{}
+
+	for _, quizID := range p.GetAttemptedQuizIDs() {
+		c, err := courseRepository.FindByQuizID(quizID)
+		if errors.Is(err, course.ErrQuizNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !courseIDs[c.ID] {
+			courseIDs[c.ID] = true
+			courses = append(courses, c)
+		}
+	}
+
+	return courses, nil
 }
 
 func (as *ParticipantApplicationService) GetQuizAttemptDetail(participantID string, quizID string, attemptIDOrLatest string) (quizattemptdetail.QuizAttemptDetail, error) {
-	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	p, err := as.findParticipant(participantID)
 	if err != nil {
 		return quizattemptdetail.QuizAttemptDetail{}, err
 	}
@@ -67,31 +282,45 @@ func (as *ParticipantApplicationService) GetQuizAttemptDetail(participantID stri
 		return quizattemptdetail.QuizAttemptDetail{}, err
 	}
 
-	quizOverview, err := quizattemptdetail.NewQuizAttemptDetail(p, quizID, attemptIDNumber)
-
-	return quizOverview, err
+	return as.startQuizToEventMapper.CreateQuizAttemptDetail(p, quizID, attemptIDNumber, as.clock.Now())
 }
 
-func (as *ParticipantApplicationService) GetLatestQuizAttemptDetail(participantID string, quizID string) (quizattemptdetail.QuizAttemptDetail, error) {
-	p, err := as.participantRepository.FindOrCreateByID(participantID)
+// GetAttemptReview returns the questions of the attempt, the answer key is revealed for answered questions and for
+// every question once the attempt is finished. The timeline lists every selected answer in chronological order.
+func (as *ParticipantApplicationService) GetAttemptReview(participantID string, quizID string, attemptIDOrLatest string) (attemptreview.AttemptReview, error) {
+	p, err := as.findParticipant(participantID)
 	if err != nil {
-		return quizattemptdetail.QuizAttemptDetail{}, err
+		return attemptreview.AttemptReview{}, err
+	}
+
+	attemptIDNumber, err := p.GetValidAttemptID(quizID, attemptIDOrLatest)
+	if err != nil {
+		return attemptreview.AttemptReview{}, err
+	}
+
+	stepQuiz, err := as.courseRepository.FindQuizByID(quizID)
+	if err != nil {
+		return attemptreview.AttemptReview{}, err
 	}
 
-	quizOverview, err := projection.NewQuizOverview(p)
+	return attemptreview.NewAttemptReview(p, stepQuiz, attemptIDNumber)
+}
+
+func (as *ParticipantApplicationService) GetLatestQuizAttemptDetail(participantID string, quizID string) (quizattemptdetail.QuizAttemptDetail, error) {
+	p, err := as.findParticipant(participantID)
 	if err != nil {
 		return quizattemptdetail.QuizAttemptDetail{}, err
 	}
 
-	latestAttempt, err := quizOverview.GetFinishedQuizLatestAttempt(quizID)
+	quizOverview, err := projection.NewQuizOverview(p, as.clock.Now())
 	if err != nil {
 		return quizattemptdetail.QuizAttemptDetail{}, err
 	}
 
-	attemptDetail, err := quizattemptdetail.NewQuizAttemptDetail(p, quizID, latestAttempt.AttemptID)
+	latestAttempt, err := quizOverview.GetFinishedQuizLatestAttempt(quizID)
 	if err != nil {
 		return quizattemptdetail.QuizAttemptDetail{}, err
 	}
 
-	return attemptDetail, nil
+	return as.startQuizToEventMapper.CreateQuizAttemptDetail(p, quizID, latestAttempt.AttemptID, as.clock.Now())
 }
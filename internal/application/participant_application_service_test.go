@@ -5,6 +5,8 @@ import (
 	"learn-to-code/internal/application"
 	"learn-to-code/internal/domain/command"
 	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/certificate"
+	"learn-to-code/internal/infrastructure/clock"
 	"learn-to-code/internal/infrastructure/config"
 	"learn-to-code/internal/infrastructure/dynamodb"
 	errUtils "learn-to-code/internal/infrastructure/go/util/err"
@@ -20,9 +22,15 @@ func SetupApplicationService() (*application.ParticipantApplicationService, *dyn
 	dynamoDbClient, clean := db.StartDynamoDB()
 
 	participantRepository := dynamodb.NewDynamoDbParticipantRepository(context.Background(), config.Test, dynamoDbClient, dynamodb.NewEventPODeserializer())
+	courseRepository := inmemory.NewCourseRepository()
+	badgeRuleRepository := inmemory.NewBadgeRuleRepository()
+	questionStatsRepository := dynamodb.NewDynamoDbQuestionStatsRepository(context.Background(), config.Test, dynamoDbClient)
 	as := application.NewPartcipantApplicationService(
 		participantRepository,
-		command.NewParticipantCommandApplier(inmemory.NewCourseRepository()),
+		courseRepository,
+		badgeRuleRepository,
+		command.NewParticipantCommandApplier(courseRepository, badgeRuleRepository, certificate.NewHmacSigner("test"), questionStatsRepository),
+		clock.NewSystemClock(),
 	)
 
 	return as, participantRepository, clean
@@ -40,7 +48,7 @@ func TestQuizApplicationService_StartQuiz(t *testing.T) {
 	}
 
 	quizID := uuid.MustNewRandomAsString()
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(quizID, []string{inmemory.FirstQuestionID}), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(quizID, []string{inmemory.FirstQuestionID}), userID))
 
 	startedQuizCount = errUtils.PanicIfError1(as.GetStartedQuizCount(userID))
 	if startedQuizCount != 1 {
@@ -60,7 +68,7 @@ func TestQuizApplicationService_MapsEventsCorrectly(t *testing.T) {
 	}
 
 	quizID := uuid.MustNewRandomAsString()
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(quizID, []string{inmemory.FirstQuestionID}), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(quizID, []string{inmemory.FirstQuestionID}), userID))
 
 	events := errUtils.PanicIfError1(participantRepository.FindEventsByParticipantID(userID))
 	quizStartedEvent := events[1].(event.StartedQuiz)
@@ -81,8 +89,8 @@ func TestQuizApplicationService_SelectAnswer(t *testing.T) {
 		t.Fatalf("new user, started quiz count not 0")
 	}
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), userID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), userID))
 }
 
 func TestQuizApplicationService_FinishQuiz(t *testing.T) {
@@ -96,9 +104,9 @@ func TestQuizApplicationService_FinishQuiz(t *testing.T) {
 		t.Fatalf("new user, started quiz count not 0")
 	}
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), userID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), userID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), userID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), userID))
 }
 
 func TestParticipantApplicationService_GetQuizAttemptDetail_NoQuizFinished_ReturnsEmpty(t *testing.T) {
@@ -107,8 +115,8 @@ func TestParticipantApplicationService_GetQuizAttemptDetail_NoQuizFinished_Retur
 
 	participantID := uuid.MustNewRandomAsString()
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
 
 	_, err := as.GetLatestQuizAttemptDetail(participantID, inmemory.QuizIDEssentialsOfTheWeb)
 
@@ -123,10 +131,10 @@ func TestParticipantApplicationService_GetQuizAttemptDetail_ReturnsFirstLatestAt
 
 	participantID := uuid.MustNewRandomAsString()
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
 
 	attemptDetail1 := errUtils.PanicIfError1(as.GetLatestQuizAttemptDetail(participantID, inmemory.QuizIDEssentialsOfTheWeb))
 
@@ -141,13 +149,13 @@ func TestParticipantApplicationService_GetQuizAttemptDetail_ReturnsLatest(t *tes
 
 	participantID := uuid.MustNewRandomAsString()
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
 
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
-	errUtils.PanicIfError(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
 
 	attemptDetail2 := errUtils.PanicIfError1(as.GetLatestQuizAttemptDetail(participantID, inmemory.QuizIDEssentialsOfTheWeb))
 
@@ -159,3 +167,49 @@ func TestParticipantApplicationService_GetQuizAttemptDetail_ReturnsLatest(t *tes
 		t.Fatalf("no question and answers for latest quiz attempt")
 	}
 }
+
+func TestParticipantApplicationService_GetStats_ReturnsTimezoneOfParticipant(t *testing.T) {
+	as, _, clean := SetupApplicationService()
+	defer clean()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSetTimezoneCommand("Europe/Berlin"), participantID))
+
+	stats := errUtils.PanicIfError1(as.GetStats(participantID))
+
+	if stats.Timezone != "Europe/Berlin" {
+		t.Fatalf("timezone is not Europe/Berlin, %s instead", stats.Timezone)
+	}
+}
+
+func TestParticipantApplicationService_FinishQuiz_AwardsBadgesAndGetBadgesDoesNotWrite(t *testing.T) {
+	as, participantRepository, clean := SetupApplicationService()
+	defer clean()
+
+	participantID := uuid.MustNewRandomAsString()
+
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateStartQuizCommand(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateSelectAnswerCommand(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID), participantID))
+	errUtils.PanicIfError1(as.ProcessCommand(commandFactory.CreateFinishQuizCommand(inmemory.QuizIDEssentialsOfTheWeb), participantID))
+
+	eventsAfterFinish := errUtils.PanicIfError1(participantRepository.FindEventsByParticipantID(participantID))
+
+	awarded := false
+	for _, e := range eventsAfterFinish {
+		if _, ok := e.(event.BadgeAwarded); ok {
+			awarded = true
+		}
+	}
+
+	if !awarded {
+		t.Fatalf("expected a badge to be awarded when the quiz is finished")
+	}
+
+	errUtils.PanicIfError1(as.GetBadges(participantID))
+
+	eventsAfterGet := errUtils.PanicIfError1(participantRepository.FindEventsByParticipantID(participantID))
+	if len(eventsAfterGet) != len(eventsAfterFinish) {
+		t.Fatalf("expected reading the badges not to store events, got %d instead of %d events", len(eventsAfterGet), len(eventsAfterFinish))
+	}
+}
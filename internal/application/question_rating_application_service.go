@@ -0,0 +1,61 @@
+package application
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/questionrating"
+)
+
+type QuestionRatingApplicationService struct {
+	participantRepository    participant.Repository
+	courseRepository         course.Repository
+	questionRatingRepository questionrating.Repository
+}
+
+func NewQuestionRatingApplicationService(participantRepository participant.Repository, courseRepository course.Repository, questionRatingRepository questionrating.Repository) *QuestionRatingApplicationService {
+	return &QuestionRatingApplicationService{
+		participantRepository:    participantRepository,
+		courseRepository:         courseRepository,
+		questionRatingRepository: questionRatingRepository,
+	}
+}
+
+// UpdateParticipantRatings stores the current question ratings of the participant. Ratings of quizzes without a
+// course are skipped and processing the same participant again is safe, e.g. for retried stream batches.
+func (as *QuestionRatingApplicationService) UpdateParticipantRatings(participantID string) error {
+	p, err := as.participantRepository.FindOrCreateByID(participantID)
+	if err != nil {
+		return err
+	}
+
+	var ratings []questionrating.Rating
+	for _, questionRating := range p.GetQuestionRatings() {
+		c, err := as.courseRepository.FindByQuizID(questionRating.QuizID)
+		if errors.Is(err, course.ErrQuizNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		ratings = append(ratings, questionrating.Rating{
+			CourseID:      c.ID,
+			QuestionID:    questionRating.QuestionID,
+			ParticipantID: p.GetID(),
+			Stars:         questionRating.Stars,
+		})
+	}
+
+	return as.questionRatingRepository.StoreRatings(ratings)
+}
+
+// AddQuestionRatings returns the course with the ratings of all participants aggregated per question.
+func (as *QuestionRatingApplicationService) AddQuestionRatings(c course.Course) (course.Course, error) {
+	ratings, err := as.questionRatingRepository.FindRatings(c.ID)
+	if err != nil {
+		return course.Course{}, err
+	}
+
+	return c.WithQuestionRatings(questionrating.Aggregate(ratings)), nil
+}
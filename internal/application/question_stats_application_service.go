@@ -0,0 +1,172 @@
+package application
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/questiontime"
+	"learn-to-code/internal/domain/quiz/questionstats"
+)
+
+type QuestionStatsApplicationService struct {
+	participantRepository   participant.Repository
+	courseRepository        course.Repository
+	questionStatsRepository questionstats.Repository
+}
+
+func NewQuestionStatsApplicationService(participantRepository participant.Repository, courseRepository course.Repository, questionStatsRepository questionstats.Repository) *QuestionStatsApplicationService {
+	return &QuestionStatsApplicationService{
+		participantRepository:   participantRepository,
+		courseRepository:        courseRepository,
+		questionStatsRepository: questionStatsRepository,
+	}
+}
+
+// ChangedParticipant is a participant whose events from FromVersion on end attempts or regrade answers.
+type ChangedParticipant struct {
+	ParticipantID string
+	FromVersion   uint
+}
+
+type courseChanges struct {
+	questionIDs []string
+	quizIDs     []string
+	seenIDs     map[string]bool
+}
+
+// UpdateQuestionStats stores the answered questions and quiz attempts of the attempts the participants ended or had
+// regraded from the given versions on and refreshes the stats of the changed questions and quizzes of each course once
+// from their latest answers and attempts. Answers of quizzes without a course are skipped and processing the same
+// participants again is safe, e.g. for retried stream batches.
+func (as *QuestionStatsApplicationService) UpdateQuestionStats(changedParticipants []ChangedParticipant) error {
+	var courseIDs []string
+	changesPerCourse := map[string]*courseChanges{}
+
+	for _, changedParticipant := range changedParticipants {
+		answeredQuestions, err := as.updateParticipantAnsweredQuestions(changedParticipant)
+		if err != nil {
+			return err
+		}
+
+		for _, answeredQuestion := range answeredQuestions {
+			changes, ok := changesPerCourse[answeredQuestion.CourseID]
+			if !ok {
+				changes = &courseChanges{seenIDs: map[string]bool{}}
+				changesPerCourse[answeredQuestion.CourseID] = changes
+				courseIDs = append(courseIDs, answeredQuestion.CourseID)
+			}
+
+			changes.add(answeredQuestion)
+		}
+	}
+
+	for _, courseID := range courseIDs {
+		courseStats, err := as.aggregateCourseChanges(courseID, changesPerCourse[courseID])
+		if err != nil {
+			return err
+		}
+
+		err = as.questionStatsRepository.StoreCourseStats(courseStats)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (as *QuestionStatsApplicationService) updateParticipantAnsweredQuestions(changedParticipant ChangedParticipant) ([]questionstats.AnsweredQuestion, error) {
+	p, err := as.participantRepository.FindOrCreateByID(changedParticipant.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var answeredQuestions []questionstats.AnsweredQuestion
+	for _, questionTime := range questiontime.NewQuestionTimes(p) {
+		if !questionTime.Ended || !questionTime.Answered || questionTime.ChangedVersion < changedParticipant.FromVersion {
+			continue
+		}
+
+		c, err := as.courseRepository.FindByQuizID(questionTime.QuizID)
+		if errors.Is(err, course.ErrQuizNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		answeredQuestions = append(answeredQuestions, questionstats.AnsweredQuestion{
+			CourseID:      c.ID,
+			QuizID:        questionTime.QuizID,
+			QuestionID:    questionTime.QuestionID,
+			ParticipantID: p.GetID(),
+			AttemptID:     questionTime.AttemptID,
+			TimeSpentSecs: questionTime.TimeSpentSecs,
+			IsCorrect:     questionTime.IsCorrect,
+			EndedAt:       questionTime.EndedAt,
+		})
+	}
+
+	err = as.questionStatsRepository.StoreAnsweredQuestions(answeredQuestions)
+	if err != nil {
+		return nil, err
+	}
+
+	return answeredQuestions, as.questionStatsRepository.StoreQuizAttempts(questionstats.NewQuizAttempts(answeredQuestions))
+}
+
+func (as *QuestionStatsApplicationService) aggregateCourseChanges(courseID string, changes *courseChanges) (questionstats.CourseStats, error) {
+	courseStats := questionstats.CourseStats{
+		CourseID:  courseID,
+		Questions: map[string]questionstats.QuestionStats{},
+		Quizzes:   map[string]questionstats.QuizStats{},
+	}
+
+	for _, questionID := range changes.questionIDs {
+		answeredQuestions, err := as.questionStatsRepository.FindLatestAnsweredQuestions(courseID, questionID)
+		if err != nil {
+			return questionstats.CourseStats{}, err
+		}
+
+		for id, questionStats := range questionstats.Aggregate(answeredQuestions) {
+			courseStats.Questions[id] = questionStats
+		}
+	}
+
+	for _, quizID := range changes.quizIDs {
+		quizAttempts, err := as.questionStatsRepository.FindLatestQuizAttempts(courseID, quizID)
+		if err != nil {
+			return questionstats.CourseStats{}, err
+		}
+
+		for id, quizStats := range questionstats.AggregateQuizzes(quizAttempts) {
+			courseStats.Quizzes[id] = quizStats
+		}
+	}
+
+	return courseStats, nil
+}
+
+func (c *courseChanges) add(answeredQuestion questionstats.AnsweredQuestion) {
+	questionKey := "question#" + answeredQuestion.QuestionID
+	if !c.seenIDs[questionKey] {
+		c.seenIDs[questionKey] = true
+		c.questionIDs = append(c.questionIDs, answeredQuestion.QuestionID)
+	}
+
+	quizKey := "quiz#" + answeredQuestion.QuizID
+	if !c.seenIDs[quizKey] {
+		c.seenIDs[quizKey] = true
+		c.quizIDs = append(c.quizIDs, answeredQuestion.QuizID)
+	}
+}
+
+// AddQuestionStats returns the course with the stored stats of its questions.
+func (as *QuestionStatsApplicationService) AddQuestionStats(c course.Course) (course.Course, error) {
+	courseStats, err := as.questionStatsRepository.FindCourseStats(c.ID)
+	if err != nil {
+		return course.Course{}, err
+	}
+
+	return c.WithQuestionStats(courseStats.Questions), nil
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+)
+
+// RegradePageSize is the number of participants regraded per call, so a call ends within the timeout of the API.
+const RegradePageSize = 100
+
+type RegradeApplicationService struct {
+	participantRepository     participant.Repository
+	courseRepository          course.Repository
+	participantCommandApplier *command.ParticipantCommandApplier
+}
+
+func NewRegradeApplicationService(participantRepository participant.Repository, courseRepository course.Repository, participantCommandApplier *command.ParticipantCommandApplier) *RegradeApplicationService {
+	return &RegradeApplicationService{
+		participantRepository:     participantRepository,
+		courseRepository:          courseRepository,
+		participantCommandApplier: participantCommandApplier,
+	}
+}
+
+// RegradeCourse compares the answers of a page of participants following afterParticipantID with the current answer
+// key of the course and returns the participants with changed answers and the participant id the next page follows,
+// which is empty after the last page. A dry run only reports the changes, otherwise they are stored as AnswerRegraded
+// events together with the badges and the certificate earned by the regraded answers. Regrading again after a
+// successful regrade changes nothing.
+func (as *RegradeApplicationService) RegradeCourse(courseID string, dryRun bool, afterParticipantID string) ([]participant.Regrade, string, error) {
+	c, err := as.courseRepository.FindByID(courseID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	participantIDs, nextParticipantID, err := as.participantRepository.FindParticipantIDPage(afterParticipantID, RegradePageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	regrades := []participant.Regrade{}
+	for _, participantID := range participantIDs {
+		p, err := as.participantRepository.FindOrCreateByID(participantID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var regrade participant.Regrade
+		if dryRun {
+			regrade = p.PlanRegrade(c)
+		} else {
+			regrade, err = p.RegradeAnswers(c)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if !regrade.IsEmpty() {
+				err = as.participantCommandApplier.ApplyRegrade(&p, c)
+				if err != nil {
+					return nil, "", err
+				}
+			}
+
+			err = as.participantRepository.StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion())
+			if err != nil {
+				return nil, "", err
+			}
+		}
+
+		if !regrade.IsEmpty() {
+			regrades = append(regrades, regrade)
+		}
+	}
+
+	return regrades, nextParticipantID, nil
+}
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -17,14 +18,62 @@ func (f *Factory) CreateStartQuizCommand(quizID string, requiredQuestionsAnswere
 	return NewCommand(StartQuizCommandType, NewStartQuizData(quizID, requiredQuestionsAnswered), time.Now())
 }
 
+func (f *Factory) CreateStartQuizCommandWithMode(quizID string, requiredQuestionsAnswered []string, mode string) Command {
+	return NewCommand(StartQuizCommandType, NewStartQuizDataWithMode(quizID, requiredQuestionsAnswered, mode), time.Now())
+}
+
+func (f *Factory) CreateRetakeQuizCommand(quizID string, retakeAttemptID int) Command {
+	return NewCommand(StartQuizCommandType, NewRetakeQuizData(quizID, retakeAttemptID), time.Now())
+}
+
 func (f *Factory) CreateSelectAnswerCommand(quizID string, questionID string, answerID string) Command {
 	return NewCommand(SelectAnswerCommandType, NewSelectAnswerData(quizID, questionID, answerID), time.Now())
 }
 
+func (f *Factory) CreateSelectAnswerCommandWithConfidence(quizID string, questionID string, answerID string, confidence string) Command {
+	return NewCommand(SelectAnswerCommandType, NewSelectAnswerDataWithConfidence(quizID, questionID, answerID, confidence), time.Now())
+}
+
+func (f *Factory) CreateRequestHintCommand(quizID string, questionID string) Command {
+	return NewCommand(RequestHintCommandType, NewRequestHintData(quizID, questionID), time.Now())
+}
+
+func (f *Factory) CreateViewQuestionCommand(quizID string, questionID string) Command {
+	return NewCommand(ViewQuestionCommandType, NewViewQuestionData(quizID, questionID), time.Now())
+}
+
 func (f *Factory) CreateFinishQuizCommand(quizID string) Command {
 	return NewCommand(FinishQuizCommandType, NewFinishQuizData(quizID), time.Now())
 }
 
+func (f *Factory) CreateReviewQuestionCommand(quizID string, questionID string, answerID string, quality int) Command {
+	return NewCommand(ReviewQuestionCommandType, NewReviewQuestionData(quizID, questionID, answerID, quality), time.Now())
+}
+
+func (f *Factory) CreateSetTimezoneCommand(timezone string) Command {
+	return NewCommand(SetTimezoneCommandType, NewSetTimezoneData(timezone), time.Now())
+}
+
+func (f *Factory) CreateSetLeaderboardOptOutCommand(optOut bool) Command {
+	return NewCommand(SetLeaderboardOptOutCommandType, NewSetLeaderboardOptOutData(optOut), time.Now())
+}
+
+func (f *Factory) CreateRateQuestionCommand(quizID string, questionID string, stars int) Command {
+	return NewCommand(RateQuestionCommandType, NewRateQuestionData(quizID, questionID, stars), time.Now())
+}
+
+func (f *Factory) CreateReportQuestionIssueCommand(quizID string, questionID string, category string, text string) Command {
+	return NewCommand(ReportQuestionIssueCommandType, NewReportQuestionIssueData(quizID, questionID, category, text), time.Now())
+}
+
+func (f *Factory) CreateResolveQuestionIssueCommand(reportID string, contentVersion string) Command {
+	return NewCommand(ResolveQuestionIssueCommandType, NewResolveQuestionIssueData(reportID, contentVersion), time.Now())
+}
+
+func (f *Factory) CreateRejectQuestionIssueCommand(reportID string, reason string) Command {
+	return NewCommand(RejectQuestionIssueCommandType, NewRejectQuestionIssueData(reportID, reason), time.Now())
+}
+
 func DecodeCommand[T any](data any, result T) (T, error) {
 	err := decode(data, result)
 	return result, err
@@ -45,3 +94,36 @@ func decode(data any, result any) error {
 
 	return err
 }
+
+// withDefaults fills in the defaults of the optional keys that are missing in the command data, because the decoding
+// fails on unset fields.
+func withDefaults(data any, defaults map[string]any) any {
+	dataMap, ok := data.(map[string]any)
+	if !ok {
+		return data
+	}
+
+	dataWithDefaults := map[string]any{}
+	for key, value := range dataMap {
+		dataWithDefaults[key] = value
+	}
+
+	for key, value := range defaults {
+		if !hasKey(dataMap, key) {
+			dataWithDefaults[key] = value
+		}
+	}
+
+	return dataWithDefaults
+}
+
+// hasKey matches the key case-insensitive like the decoding of the command data.
+func hasKey(dataMap map[string]any, key string) bool {
+	for dataKey := range dataMap {
+		if strings.EqualFold(dataKey, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package command
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/moderation"
+)
+
+type ModerationCommandApplier struct {
+}
+
+func NewModerationCommandApplier() *ModerationCommandApplier {
+	return &ModerationCommandApplier{}
+}
+
+func (m *ModerationCommandApplier) ApplyCommand(c Command, q moderation.Queue) (moderation.Queue, error) {
+	switch c.Type {
+	case ResolveQuestionIssueCommandType:
+		resolveQuestionIssueData, err := DecodeCommand(c.Data, &ResolveQuestionIssue{})
+		if err != nil {
+			return moderation.Queue{}, err
+		}
+
+		err = q.Resolve(resolveQuestionIssueData.ReportID, resolveQuestionIssueData.ContentVersion)
+		if err != nil {
+			return moderation.Queue{}, err
+		}
+
+	case RejectQuestionIssueCommandType:
+		rejectQuestionIssueData, err := DecodeCommand(c.Data, &RejectQuestionIssue{})
+		if err != nil {
+			return moderation.Queue{}, err
+		}
+
+		err = q.Reject(rejectQuestionIssueData.ReportID, rejectQuestionIssueData.Reason)
+		if err != nil {
+			return moderation.Queue{}, err
+		}
+
+	default:
+		return q, fmt.Errorf("unknown c type '%s'", c.Type)
+	}
+
+	return q, nil
+}
@@ -1,39 +1,63 @@
 package command
 
 import (
+	"errors"
 	"fmt"
+	"learn-to-code/internal/domain/quiz/badge"
 	"learn-to-code/internal/domain/quiz/course"
 	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+	"learn-to-code/internal/domain/quiz/participant/projection/reviewqueue"
+	"learn-to-code/internal/domain/quiz/questionstats"
 	"learn-to-code/internal/infrastructure/inmemory"
+	"time"
 )
 
 type ParticipantCommandApplier struct {
-	courseRepository course.Repository
+	courseRepository        course.Repository
+	badgeRuleRepository     badge.Repository
+	certificateSigner       participant.CertificateSigner
+	questionStatsRepository questionstats.Repository
 }
 
-func NewParticipantCommandApplier(courseRepository course.Repository) *ParticipantCommandApplier {
+func NewParticipantCommandApplier(courseRepository course.Repository, badgeRuleRepository badge.Repository, certificateSigner participant.CertificateSigner, questionStatsRepository questionstats.Repository) *ParticipantCommandApplier {
 	return &ParticipantCommandApplier{
-		courseRepository: courseRepository,
+		courseRepository:        courseRepository,
+		badgeRuleRepository:     badgeRuleRepository,
+		certificateSigner:       certificateSigner,
+		questionStatsRepository: questionStatsRepository,
 	}
 }
 
-func (m *ParticipantCommandApplier) ApplyCommand(c Command, p participant.Participant) (participant.Participant, error) {
+// ApplyCommand applies the command to the participant, now is used to check if a reviewed question is due.
+func (m *ParticipantCommandApplier) ApplyCommand(c Command, p participant.Participant, now time.Time) (participant.Participant, error) {
 	var courses map[string]course.Course = map[string]course.Course{}
 
 	switch c.Type {
 	case StartQuizCommandType:
-		startQuiz, err := DecodeCommand(c.Data, &StartQuiz{})
+		startQuiz, err := DecodeStartQuiz(c.Data)
 		if err != nil {
 			return participant.Participant{}, err
 		}
 
-		err = p.StartQuiz(startQuiz.QuizID, startQuiz.RequiredQuestionsAnswered)
+		settings, err := m.FindAttemptSettings(startQuiz.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		settings.Mode, err = participant.ParseAttemptMode(startQuiz.Mode)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+		settings.RetakeAttemptID = startQuiz.RetakeAttemptID
+
+		err = p.StartQuizWithSettings(startQuiz.QuizID, startQuiz.RequiredQuestionsAnswered, settings)
 		if err != nil {
 			return participant.Participant{}, err
 		}
 
 	case SelectAnswerCommandType:
-		selectAnswerData, err := DecodeCommand(c.Data, &SelectAnswer{})
+		selectAnswerData, err := DecodeSelectAnswer(c.Data)
 		if err != nil {
 			return participant.Participant{}, err
 		}
@@ -49,8 +73,57 @@ func (m *ParticipantCommandApplier) ApplyCommand(c Command, p participant.Partic
 
 		isAnswerCorrect := m.isAnswerCorrect(courses, selectAnswerData)
 
-		selectQuizErr := p.SelectQuizAnswer(selectAnswerData.QuizID, selectAnswerData.QuestionID, selectAnswerData.AnswerID, isAnswerCorrect)
-		if selectQuizErr != nil {
+		stepQuiz, err := m.findQuiz(selectAnswerData.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		if p.IsLatestAttemptAdaptive(selectAnswerData.QuizID) {
+			selectQuizErr := p.SelectAdaptiveQuizAnswer(stepQuiz, selectAnswerData.QuestionID, selectAnswerData.AnswerID, isAnswerCorrect, selectAnswerData.Confidence)
+			if selectQuizErr != nil {
+				return participant.Participant{}, selectQuizErr
+			}
+
+			_, err = p.FinishSettledAdaptiveQuiz(stepQuiz)
+			if err != nil {
+				return participant.Participant{}, err
+			}
+
+			err = m.completeCourse(&p, selectAnswerData.QuizID)
+			if err != nil {
+				return participant.Participant{}, err
+			}
+		} else {
+			selectQuizErr := p.SelectQuizAnswerWithConfidence(selectAnswerData.QuizID, selectAnswerData.QuestionID, selectAnswerData.AnswerID, isAnswerCorrect, selectAnswerData.Confidence)
+			if selectQuizErr != nil {
+				return participant.Participant{}, selectQuizErr
+			}
+		}
+
+	case RequestHintCommandType:
+		requestHintData, err := DecodeCommand(c.Data, &RequestHint{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		stepQuiz, err := m.courseRepository.FindQuizByID(requestHintData.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		_, err = p.RequestHint(stepQuiz, requestHintData.QuestionID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case ViewQuestionCommandType:
+		viewQuestionData, err := DecodeCommand(c.Data, &ViewQuestion{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = p.ViewQuestion(viewQuestionData.QuizID, viewQuestionData.QuestionID)
+		if err != nil {
 			return participant.Participant{}, err
 		}
 
@@ -60,7 +133,101 @@ func (m *ParticipantCommandApplier) ApplyCommand(c Command, p participant.Partic
 			return participant.Participant{}, err
 		}
 
-		err = p.FinishQuiz(finishQuizData.QuizID)
+		stepQuiz, err := m.findQuiz(finishQuizData.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		if p.IsLatestAttemptAdaptive(finishQuizData.QuizID) {
+			err = p.FinishAdaptiveQuiz(stepQuiz)
+		} else {
+			err = p.FinishQuiz(finishQuizData.QuizID)
+		}
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = m.completeCourse(&p, finishQuizData.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case ReviewQuestionCommandType:
+		reviewQuestionData, err := DecodeCommand(c.Data, &ReviewQuestion{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		stepQuiz, err := m.courseRepository.FindQuizByID(reviewQuestionData.QuizID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		isAnswerCorrect, err := m.isReviewAnswerCorrect(stepQuiz, reviewQuestionData)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = m.ensureReviewIsDue(p, reviewQuestionData, now)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = p.ReviewQuestion(reviewQuestionData.QuizID, reviewQuestionData.QuestionID, reviewQuestionData.AnswerID, isAnswerCorrect, reviewQuestionData.Quality)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case SetTimezoneCommandType:
+		setTimezoneData, err := DecodeCommand(c.Data, &SetTimezone{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = p.SetTimezone(setTimezoneData.Timezone)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case SetLeaderboardOptOutCommandType:
+		setLeaderboardOptOutData, err := DecodeCommand(c.Data, &SetLeaderboardOptOut{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = p.SetLeaderboardOptOut(setLeaderboardOptOutData.OptOut)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case RateQuestionCommandType:
+		rateQuestionData, err := DecodeCommand(c.Data, &RateQuestion{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = m.ensureQuestionExists(rateQuestionData.QuizID, rateQuestionData.QuestionID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = p.RateQuestion(rateQuestionData.QuizID, rateQuestionData.QuestionID, rateQuestionData.Stars)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+	case ReportQuestionIssueCommandType:
+		reportQuestionIssueData, err := DecodeCommand(c.Data, &ReportQuestionIssue{})
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		err = m.ensureQuestionExists(reportQuestionIssueData.QuizID, reportQuestionIssueData.QuestionID)
+		if err != nil {
+			return participant.Participant{}, err
+		}
+
+		_, err = p.ReportQuestionIssue(reportQuestionIssueData.QuizID, reportQuestionIssueData.QuestionID, reportQuestionIssueData.Category, reportQuestionIssueData.Text)
 		if err != nil {
 			return participant.Participant{}, err
 		}
@@ -72,6 +239,206 @@ func (m *ParticipantCommandApplier) ApplyCommand(c Command, p participant.Partic
 	return p, nil
 }
 
+// ApplyQuizTimeOuts awards the badges and issues the certificates earned by the attempts of the timed out quizzes.
+func (m *ParticipantCommandApplier) ApplyQuizTimeOuts(p *participant.Participant, timedOutQuizIDs []string) error {
+	for _, quizID := range timedOutQuizIDs {
+		err := m.completeCourse(p, quizID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyRegrade awards the badges and issues the certificate earned by the regraded answers of the course. Badges and
+// certificates earned before the regrade are kept, even if the regraded answers no longer meet their rules.
+func (m *ParticipantCommandApplier) ApplyRegrade(p *participant.Participant, c course.Course) error {
+	return m.awardCourse(p, c)
+}
+
+// completeCourse awards the badges whose rules the finished attempt meets and issues the certificate of the course
+// containing the quiz once every step of it is passed
+func (m *ParticipantCommandApplier) completeCourse(p *participant.Participant, quizID string) error {
+	c, err := m.courseRepository.FindByQuizID(quizID)
+	if errors.Is(err, course.ErrQuizNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.awardCourse(p, c)
+}
+
+// awardCourse awards the badges whose rules the finished attempts of the course meet and issues the certificate of the
+// course once every step of it is passed
+func (m *ParticipantCommandApplier) awardCourse(p *participant.Participant, c course.Course) error {
+	rules, err := m.badgeRuleRepository.FindRulesByCourseID(c.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.AwardBadges(c, rules)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.CompleteCourse(c, m.certificateSigner)
+
+	return err
+}
+
+// FindAttemptSettings returns the settings of the quiz within its course, unknown quizzes have no settings
+func (m *ParticipantCommandApplier) FindAttemptSettings(quizID string) (participant.AttemptSettings, error) {
+	c, err := m.courseRepository.FindByQuizID(quizID)
+	if errors.Is(err, course.ErrQuizNotFound) {
+		return participant.AttemptSettings{}, nil
+	}
+	if err != nil {
+		return participant.AttemptSettings{}, err
+	}
+
+	stepQuiz, _ := c.FindQuiz(quizID)
+
+	return participant.NewCourseAttemptSettings(c, stepQuiz), nil
+}
+
+// findQuiz returns the quiz definition of the course, unknown quizzes have no definition and return an empty quiz
+func (m *ParticipantCommandApplier) findQuiz(quizID string) (course.StepQuiz, error) {
+	stepQuiz, err := m.courseRepository.FindQuizByID(quizID)
+	if errors.Is(err, course.ErrQuizNotFound) {
+		return course.StepQuiz{}, nil
+	}
+
+	return stepQuiz, err
+}
+
+// ensureQuestionExists returns ErrQuizNotFound or ErrQuestionNotFound if the question is not part of the quiz definition
+func (m *ParticipantCommandApplier) ensureQuestionExists(quizID string, questionID string) error {
+	stepQuiz, err := m.courseRepository.FindQuizByID(quizID)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := stepQuiz.FindQuestion(questionID); !ok {
+		return fmt.Errorf("question %v of quiz %v: %w", questionID, quizID, course.ErrQuestionNotFound)
+	}
+
+	return nil
+}
+
+// CreateResult returns the feedback to the applied command, now is used to calculate the result of a finished attempt.
+func (m *ParticipantCommandApplier) CreateResult(c Command, p participant.Participant, now time.Time) (Result, error) {
+	result := Result{
+		Type: c.Type,
+	}
+
+	switch c.Type {
+	case SelectAnswerCommandType:
+		selectAnswerData, err := DecodeSelectAnswer(c.Data)
+		if err != nil {
+			return Result{}, err
+		}
+
+		stepQuiz, err := m.findQuiz(selectAnswerData.QuizID)
+		if err != nil {
+			return Result{}, err
+		}
+
+		attemptProgress, err := p.GetAttemptProgress(selectAnswerData.QuizID)
+		if err != nil {
+			return Result{}, err
+		}
+		result.AttemptProgress = &attemptProgress
+
+		// practice attempts always give instant feedback
+		if !stepQuiz.FeedbackDisabled || attemptProgress.Practice {
+			answerFeedback, err := p.GetAnswerFeedback(stepQuiz, selectAnswerData.QuestionID)
+			if err != nil {
+				return Result{}, err
+			}
+			result.AnswerFeedback = &answerFeedback
+		}
+
+		// settled adaptive attempts finish with the answer
+		if attemptProgress.Completed {
+			result.AttemptResult, err = m.createAttemptResult(p, selectAnswerData.QuizID, now)
+			if err != nil {
+				return Result{}, err
+			}
+		}
+
+	case RequestHintCommandType:
+		requestHintData, err := DecodeCommand(c.Data, &RequestHint{})
+		if err != nil {
+			return Result{}, err
+		}
+
+		stepQuiz, err := m.findQuiz(requestHintData.QuizID)
+		if err != nil {
+			return Result{}, err
+		}
+
+		usedHints := p.GetUsedHints(stepQuiz, requestHintData.QuestionID)
+		if len(usedHints) == 0 {
+			return Result{}, fmt.Errorf("no hint of question %v was requested in the latest attempt of quiz %v", requestHintData.QuestionID, requestHintData.QuizID)
+		}
+		result.Hint = &usedHints[len(usedHints)-1]
+
+	case FinishQuizCommandType:
+		finishQuizData, err := DecodeCommand(c.Data, &FinishQuiz{})
+		if err != nil {
+			return Result{}, err
+		}
+
+		result.AttemptResult, err = m.createAttemptResult(p, finishQuizData.QuizID, now)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func (m *ParticipantCommandApplier) createAttemptResult(p participant.Participant, quizID string, now time.Time) (*quizattemptdetail.AttemptResult, error) {
+	attemptDetail, err := m.CreateQuizAttemptDetail(p, quizID, p.GetQuizAttemptCount(quizID), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attemptDetail.AttemptResult, nil
+}
+
+// CreateQuizAttemptDetail creates the detail of the attempt and compares it with the stats of all participants for
+// the quiz, quizzes without a course have no stats.
+func (m *ParticipantCommandApplier) CreateQuizAttemptDetail(p participant.Participant, quizID string, attemptID int, now time.Time) (quizattemptdetail.QuizAttemptDetail, error) {
+	quizStats, err := m.findQuizStats(quizID)
+	if err != nil {
+		return quizattemptdetail.QuizAttemptDetail{}, err
+	}
+
+	return quizattemptdetail.NewQuizAttemptDetailWithQuizStats(p, quizID, attemptID, now, quizStats)
+}
+
+// findQuizStats returns the stored stats of all participants for the quiz, quizzes without a course have no stats.
+func (m *ParticipantCommandApplier) findQuizStats(quizID string) (questionstats.QuizStats, error) {
+	c, err := m.courseRepository.FindByQuizID(quizID)
+	if errors.Is(err, course.ErrQuizNotFound) {
+		return questionstats.QuizStats{}, nil
+	}
+	if err != nil {
+		return questionstats.QuizStats{}, err
+	}
+
+	courseStats, err := m.questionStatsRepository.FindCourseStats(c.ID)
+	if err != nil {
+		return questionstats.QuizStats{}, err
+	}
+
+	return courseStats.Quizzes[quizID], nil
+}
+
 func (m *ParticipantCommandApplier) isAnswerCorrect(courses map[string]course.Course, selectAnswerData *SelectAnswer) bool {
 	var isAnswerCorrect bool
 	for _, step := range courses[selectAnswerData.QuizID].Steps {
@@ -91,3 +458,29 @@ func (m *ParticipantCommandApplier) isAnswerCorrect(courses map[string]course.Co
 	}
 	return isAnswerCorrect
 }
+
+// isReviewAnswerCorrect returns ErrQuestionNotFound if the question or the answer is not part of the quiz definition
+func (m *ParticipantCommandApplier) isReviewAnswerCorrect(stepQuiz course.StepQuiz, reviewQuestionData *ReviewQuestion) (bool, error) {
+	question, ok := stepQuiz.FindQuestion(reviewQuestionData.QuestionID)
+	if !ok {
+		return false, fmt.Errorf("question %v of quiz %v: %w", reviewQuestionData.QuestionID, stepQuiz.ID, course.ErrQuestionNotFound)
+	}
+
+	for _, answer := range question.Answers {
+		if answer.ID == reviewQuestionData.AnswerID {
+			return answer.IsCorrect, nil
+		}
+	}
+
+	return false, fmt.Errorf("answer %v of question %v: %w", reviewQuestionData.AnswerID, reviewQuestionData.QuestionID, course.ErrQuestionNotFound)
+}
+
+// ensureReviewIsDue returns ErrReviewNotDue unless the question is in the review queue of the participant and due at now
+func (m *ParticipantCommandApplier) ensureReviewIsDue(p participant.Participant, reviewQuestionData *ReviewQuestion, now time.Time) error {
+	item, ok := reviewqueue.NewReviewQueue(p).Items[reviewQuestionData.QuestionID]
+	if !ok || item.QuizID != reviewQuestionData.QuizID || item.DueAt.After(now) {
+		return fmt.Errorf("question %v of quiz %v: %w", reviewQuestionData.QuestionID, reviewQuestionData.QuizID, participant.ErrReviewNotDue)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package command
+
+func NewRateQuestionData(quizID string, questionID string, stars int) RateQuestion {
+	return RateQuestion{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		Stars:      stars,
+	}
+}
+
+// RateQuestion rates a question with 1 to 5 stars, rating the question again replaces the previous rating.
+type RateQuestion struct {
+	QuizID     string
+	QuestionID string
+	Stars      int
+}
+
+const RateQuestionCommandType = "RateQuestion"
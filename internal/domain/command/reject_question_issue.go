@@ -0,0 +1,16 @@
+package command
+
+func NewRejectQuestionIssueData(reportID string, reason string) RejectQuestionIssue {
+	return RejectQuestionIssue{
+		ReportID: reportID,
+		Reason:   reason,
+	}
+}
+
+// RejectQuestionIssue closes a report that does not need a content change.
+type RejectQuestionIssue struct {
+	ReportID string
+	Reason   string
+}
+
+const RejectQuestionIssueCommandType = "RejectQuestionIssue"
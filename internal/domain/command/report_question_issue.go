@@ -0,0 +1,20 @@
+package command
+
+func NewReportQuestionIssueData(quizID string, questionID string, category string, text string) ReportQuestionIssue {
+	return ReportQuestionIssue{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		Category:   category,
+		Text:       text,
+	}
+}
+
+// ReportQuestionIssue reports a problem with a question, the category is one of wrong-answer, typo, unclear or other.
+type ReportQuestionIssue struct {
+	QuizID     string
+	QuestionID string
+	Category   string
+	Text       string
+}
+
+const ReportQuestionIssueCommandType = "ReportQuestionIssue"
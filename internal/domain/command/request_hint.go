@@ -0,0 +1,16 @@
+package command
+
+func NewRequestHintData(quizID string, questionID string) RequestHint {
+	return RequestHint{
+		QuizID:     quizID,
+		QuestionID: questionID,
+	}
+}
+
+// RequestHint reveals the next hint of a question in the ongoing attempt, every hint reduces the score of the question.
+type RequestHint struct {
+	QuizID     string
+	QuestionID string
+}
+
+const RequestHintCommandType = "RequestHint"
@@ -0,0 +1,16 @@
+package command
+
+func NewResolveQuestionIssueData(reportID string, contentVersion string) ResolveQuestionIssue {
+	return ResolveQuestionIssue{
+		ReportID:       reportID,
+		ContentVersion: contentVersion,
+	}
+}
+
+// ResolveQuestionIssue closes a report, ContentVersion references the quiz content that fixed the problem.
+type ResolveQuestionIssue struct {
+	ReportID       string
+	ContentVersion string
+}
+
+const ResolveQuestionIssueCommandType = "ResolveQuestionIssue"
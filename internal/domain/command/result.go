@@ -0,0 +1,21 @@
+package command
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+)
+
+// Result is the authoritative feedback to a processed command, commands without feedback have an empty result.
+type Result struct {
+	Type string
+
+	// AnswerFeedback is nil for quizzes with disabled feedback
+	AnswerFeedback *participant.AnswerFeedback
+
+	AttemptProgress *participant.AttemptProgress
+
+	AttemptResult *quizattemptdetail.AttemptResult
+
+	// Hint is the hint revealed by a RequestHint command
+	Hint *participant.Hint
+}
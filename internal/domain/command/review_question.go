@@ -0,0 +1,19 @@
+package command
+
+func NewReviewQuestionData(quizID string, questionID string, answerID string, quality int) ReviewQuestion {
+	return ReviewQuestion{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		AnswerID:   answerID,
+		Quality:    quality,
+	}
+}
+
+type ReviewQuestion struct {
+	QuizID     string
+	QuestionID string
+	AnswerID   string
+	Quality    int
+}
+
+const ReviewQuestionCommandType = "ReviewQuestion"
@@ -1,10 +1,17 @@
 package command
 
 func NewSelectAnswerData(quizID string, questionID string, answerID string) SelectAnswer {
+	return NewSelectAnswerDataWithConfidence(quizID, questionID, answerID, "")
+}
+
+// NewSelectAnswerDataWithConfidence selects the answer with the confidence, an empty confidence selects the answer
+// without confidence.
+func NewSelectAnswerDataWithConfidence(quizID string, questionID string, answerID string, confidence string) SelectAnswer {
 	return SelectAnswer{
 		QuizID:     quizID,
 		QuestionID: questionID,
 		AnswerID:   answerID,
+		Confidence: confidence,
 	}
 }
 
@@ -12,6 +19,19 @@ type SelectAnswer struct {
 	QuizID     string
 	QuestionID string
 	AnswerID   string
+
+	// Confidence is low, medium or high, commands without a confidence select the answer without confidence.
+	Confidence string
 }
 
 const SelectAnswerCommandType = "SelectAnswer"
+
+// selectAnswerDefaults are the values of the keys that were added after the command and are therefore optional.
+var selectAnswerDefaults = map[string]any{
+	"confidence": "",
+}
+
+// DecodeSelectAnswer decodes the command data and fills in the optional keys that are missing.
+func DecodeSelectAnswer(data any) (*SelectAnswer, error) {
+	return DecodeCommand(withDefaults(data, selectAnswerDefaults), &SelectAnswer{})
+}
@@ -0,0 +1,14 @@
+package command
+
+func NewSetLeaderboardOptOutData(optOut bool) SetLeaderboardOptOut {
+	return SetLeaderboardOptOut{
+		OptOut: optOut,
+	}
+}
+
+// SetLeaderboardOptOut hides the participant from all leaderboards, false shows the participant again.
+type SetLeaderboardOptOut struct {
+	OptOut bool
+}
+
+const SetLeaderboardOptOutCommandType = "SetLeaderboardOptOut"
@@ -0,0 +1,14 @@
+package command
+
+func NewSetTimezoneData(timezone string) SetTimezone {
+	return SetTimezone{
+		Timezone: timezone,
+	}
+}
+
+// SetTimezone stores the IANA timezone of the participant, e.g. "Europe/Berlin".
+type SetTimezone struct {
+	Timezone string
+}
+
+const SetTimezoneCommandType = "SetTimezone"
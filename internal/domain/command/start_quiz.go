@@ -1,15 +1,56 @@
 package command
 
 func NewStartQuizData(quizID string, requiredQuestionsAnswered []string) StartQuiz {
+	return NewStartQuizDataWithMode(quizID, requiredQuestionsAnswered, "")
+}
+
+// NewStartQuizDataWithMode starts an attempt in the mode, an empty mode starts an exam attempt.
+func NewStartQuizDataWithMode(quizID string, requiredQuestionsAnswered []string, mode string) StartQuiz {
 	return StartQuiz{
 		QuizID:                    quizID,
 		RequiredQuestionsAnswered: requiredQuestionsAnswered,
+		Mode:                      mode,
+	}
+}
+
+// NewRetakeQuizData starts an attempt with the wrong answered questions of the finished attempt.
+func NewRetakeQuizData(quizID string, retakeAttemptID int) StartQuiz {
+	return StartQuiz{
+		QuizID:          quizID,
+		RetakeAttemptID: retakeAttemptID,
 	}
 }
 
 type StartQuiz struct {
 	QuizID                    string
 	RequiredQuestionsAnswered []string
+
+	// Mode is either exam or practice, commands without a mode start an exam attempt.
+	Mode string
+
+	// RetakeAttemptID is the attempt whose wrong answered questions are retaken, commands without it start an attempt
+	// of the whole quiz.
+	RetakeAttemptID int
 }
 
 const StartQuizCommandType = "StartQuiz"
+
+// startQuizDefaults are the values of the keys that were added after the command and are therefore optional.
+var startQuizDefaults = map[string]any{
+	"mode":            "",
+	"retakeAttemptId": 0,
+}
+
+// DecodeStartQuiz decodes the command data and fills in the optional keys that are missing. Retakes draw their questions
+// from the retaken attempt, so they do not need to send the required questions.
+func DecodeStartQuiz(data any) (*StartQuiz, error) {
+	defaults := startQuizDefaults
+	if dataMap, ok := data.(map[string]any); ok && hasKey(dataMap, "retakeAttemptId") {
+		defaults = map[string]any{"requiredQuestionsAnswered": nil}
+		for key, value := range startQuizDefaults {
+			defaults[key] = value
+		}
+	}
+
+	return DecodeCommand(withDefaults(data, defaults), &StartQuiz{})
+}
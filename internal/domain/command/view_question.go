@@ -0,0 +1,17 @@
+package command
+
+func NewViewQuestionData(quizID string, questionID string) ViewQuestion {
+	return ViewQuestion{
+		QuizID:     quizID,
+		QuestionID: questionID,
+	}
+}
+
+// ViewQuestion records that a question of the ongoing attempt is shown to the participant, it is used to measure the
+// time spent on every question.
+type ViewQuestion struct {
+	QuizID     string
+	QuestionID string
+}
+
+const ViewQuestionCommandType = "ViewQuestion"
@@ -0,0 +1,12 @@
+package badge
+
+import "time"
+
+// AwardedBadge is a badge a participant earned in a course. Name and description are taken from the current rule.
+type AwardedBadge struct {
+	CourseID    string
+	BadgeID     string
+	Name        string
+	Description string
+	AwardedAt   time.Time
+}
@@ -0,0 +1,6 @@
+package badge
+
+type Repository interface {
+	// FindRulesByCourseID returns the badge rules of the course, courses without badges have no rules.
+	FindRulesByCourseID(courseID string) ([]Rule, error)
+}
@@ -0,0 +1,108 @@
+package badge
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrUnknownCondition = errors.New("unknown badge condition")
+
+// Condition decides which finished quiz attempts count towards a badge.
+type Condition string
+
+const (
+	ConditionQuizPassed           Condition = "quizPassed"
+	ConditionPerfectScore         Condition = "perfectScore"
+	ConditionPassedOnFirstAttempt Condition = "passedOnFirstAttempt"
+)
+
+// Rule awards a badge once Count different quizzes of the course have an attempt matching the condition.
+// With WithinDays set, the matching attempts have to be finished within that many days.
+type Rule struct {
+	BadgeID     string
+	Name        string
+	Description string
+	Condition   Condition
+	Count       int
+	WithinDays  int
+}
+
+// FinishedAttempt is a finished quiz attempt of a participant as seen by the rules.
+type FinishedAttempt struct {
+	QuizID string
+
+	// AttemptNumber starts at 1 for the first attempt of the quiz
+	AttemptNumber int
+	FinishedAt    time.Time
+	Pass          bool
+	Perfect       bool
+}
+
+func (r Rule) Validate() error {
+	switch r.Condition {
+	case ConditionQuizPassed, ConditionPerfectScore, ConditionPassedOnFirstAttempt:
+		return nil
+	default:
+		return fmt.Errorf("badge %v has condition '%v': %w", r.BadgeID, r.Condition, ErrUnknownCondition)
+	}
+}
+
+// IsMetBy evaluates the rule only based on the given attempts, so the result is the same whenever the attempts are replayed.
+func (r Rule) IsMetBy(attempts []FinishedAttempt) bool {
+	var matchingAttempts []FinishedAttempt
+	for _, attempt := range attempts {
+		if r.matches(attempt) {
+			matchingAttempts = append(matchingAttempts, attempt)
+		}
+	}
+
+	sort.Slice(matchingAttempts, func(i, j int) bool {
+		return matchingAttempts[i].FinishedAt.Before(matchingAttempts[j].FinishedAt)
+	})
+
+	for i, windowEnd := range matchingAttempts {
+		quizIDs := map[string]bool{}
+		for _, attempt := range matchingAttempts[:i+1] {
+			if r.isWithinWindow(attempt, windowEnd) {
+				quizIDs[attempt.QuizID] = true
+			}
+		}
+
+		if len(quizIDs) >= r.requiredCount() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) matches(attempt FinishedAttempt) bool {
+	switch r.Condition {
+	case ConditionQuizPassed:
+		return attempt.Pass
+	case ConditionPerfectScore:
+		return attempt.Perfect
+	case ConditionPassedOnFirstAttempt:
+		return attempt.Pass && attempt.AttemptNumber == 1
+	default:
+		return false
+	}
+}
+
+func (r Rule) isWithinWindow(attempt FinishedAttempt, windowEnd FinishedAttempt) bool {
+	if r.WithinDays == 0 {
+		return true
+	}
+
+	return windowEnd.FinishedAt.Sub(attempt.FinishedAt) < time.Duration(r.WithinDays)*24*time.Hour
+}
+
+func (r Rule) requiredCount() int {
+	if r.Count == 0 {
+		return 1
+	}
+
+	return r.Count
+}
@@ -0,0 +1,75 @@
+package badge_test
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/badge"
+	"testing"
+	"time"
+)
+
+var startedAt = time.Date(2023, 11, 6, 10, 0, 0, 0, time.UTC)
+
+func TestRule_IsMetBy_CountsDifferentQuizzes(t *testing.T) {
+	rule := badge.Rule{BadgeID: "two-quizzes", Condition: badge.ConditionQuizPassed, Count: 2}
+
+	sameQuizTwice := []badge.FinishedAttempt{
+		{QuizID: "quiz-1", AttemptNumber: 1, FinishedAt: startedAt, Pass: true},
+		{QuizID: "quiz-1", AttemptNumber: 2, FinishedAt: startedAt.Add(time.Hour), Pass: true},
+	}
+	if rule.IsMetBy(sameQuizTwice) {
+		t.Fatalf("expected the same quiz to count once")
+	}
+
+	twoQuizzes := append(sameQuizTwice, badge.FinishedAttempt{QuizID: "quiz-2", AttemptNumber: 1, FinishedAt: startedAt, Pass: true})
+	if !rule.IsMetBy(twoQuizzes) {
+		t.Fatalf("expected the rule to be met by two passed quizzes")
+	}
+}
+
+func TestRule_IsMetBy_RequiresAttemptsWithinDays(t *testing.T) {
+	rule := badge.Rule{BadgeID: "two-quizzes-in-a-week", Condition: badge.ConditionQuizPassed, Count: 2, WithinDays: 7}
+
+	spreadAttempts := []badge.FinishedAttempt{
+		{QuizID: "quiz-1", AttemptNumber: 1, FinishedAt: startedAt, Pass: true},
+		{QuizID: "quiz-2", AttemptNumber: 1, FinishedAt: startedAt.Add(8 * 24 * time.Hour), Pass: true},
+	}
+	if rule.IsMetBy(spreadAttempts) {
+		t.Fatalf("expected attempts more than 7 days apart not to meet the rule")
+	}
+
+	withinAWeek := append(spreadAttempts, badge.FinishedAttempt{QuizID: "quiz-3", AttemptNumber: 1, FinishedAt: startedAt.Add(10 * 24 * time.Hour), Pass: true})
+	if !rule.IsMetBy(withinAWeek) {
+		t.Fatalf("expected two attempts within 7 days to meet the rule")
+	}
+}
+
+func TestRule_IsMetBy_PassedOnFirstAttempt(t *testing.T) {
+	rule := badge.Rule{BadgeID: "first-attempt", Condition: badge.ConditionPassedOnFirstAttempt}
+
+	if rule.IsMetBy([]badge.FinishedAttempt{{QuizID: "quiz-1", AttemptNumber: 2, FinishedAt: startedAt, Pass: true}}) {
+		t.Fatalf("expected a pass on the second attempt not to meet the rule")
+	}
+
+	if !rule.IsMetBy([]badge.FinishedAttempt{{QuizID: "quiz-1", AttemptNumber: 1, FinishedAt: startedAt, Pass: true}}) {
+		t.Fatalf("expected a pass on the first attempt to meet the rule")
+	}
+}
+
+func TestRule_IsMetBy_PerfectScore(t *testing.T) {
+	rule := badge.Rule{BadgeID: "perfect", Condition: badge.ConditionPerfectScore}
+
+	if rule.IsMetBy([]badge.FinishedAttempt{{QuizID: "quiz-1", AttemptNumber: 1, FinishedAt: startedAt, Pass: true}}) {
+		t.Fatalf("expected a pass without perfect score not to meet the rule")
+	}
+
+	if !rule.IsMetBy([]badge.FinishedAttempt{{QuizID: "quiz-1", AttemptNumber: 1, FinishedAt: startedAt, Pass: true, Perfect: true}}) {
+		t.Fatalf("expected a perfect score to meet the rule")
+	}
+}
+
+func TestRule_Validate_RejectsUnknownCondition(t *testing.T) {
+	err := badge.Rule{BadgeID: "unknown", Condition: "unknown"}.Validate()
+	if !errors.Is(err, badge.ErrUnknownCondition) {
+		t.Fatalf("expected unknown condition error, got %v", err)
+	}
+}
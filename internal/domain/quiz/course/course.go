@@ -1,7 +1,161 @@
 package course
 
+import (
+	"learn-to-code/internal/domain/quiz/questionrating"
+	"learn-to-code/internal/domain/quiz/questionstats"
+)
+
 type Course struct {
 	ID    string
 	Steps []Step
 	Name  string
 }
+
+func (c Course) FindQuiz(quizID string) (StepQuiz, bool) {
+	for _, step := range c.Steps {
+		for _, quiz := range step.Quizzes {
+			if quiz.ID == quizID {
+				return quiz, true
+			}
+		}
+	}
+
+	return StepQuiz{}, false
+}
+
+func (c Course) FindStepByQuizID(quizID string) (Step, bool) {
+	for _, step := range c.Steps {
+		for _, quiz := range step.Quizzes {
+			if quiz.ID == quizID {
+				return step, true
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// GetQuestionDifficulties maps the id of every question of the course to its difficulty.
+func (c Course) GetQuestionDifficulties() map[string]string {
+	questionDifficulties := map[string]string{}
+	for _, step := range c.Steps {
+		for _, quiz := range step.Quizzes {
+			for _, question := range quiz.Questions {
+				questionDifficulties[question.ID] = question.Difficulty
+			}
+		}
+	}
+
+	return questionDifficulties
+}
+
+// IsPassed returns true if every step of the course has been passed.
+func (c Course) IsPassed(passedQuizIDs map[string]bool) bool {
+	for _, step := range c.Steps {
+		if !step.IsPassed(passedQuizIDs) {
+			return false
+		}
+	}
+
+	return len(c.Steps) > 0
+}
+
+// GetUnmetPrerequisites returns the prerequisites of the step that are not met by the passed quizzes.
+func (c Course) GetUnmetPrerequisites(step Step, passedQuizIDs map[string]bool) []Prerequisite {
+	passedStepIDs := map[string]bool{}
+	for _, s := range c.Steps {
+		if s.IsPassed(passedQuizIDs) {
+			passedStepIDs[s.ID] = true
+		}
+	}
+
+	var unmetPrerequisites []Prerequisite
+	for _, prerequisite := range step.Prerequisites {
+		passedSteps := 0
+		for _, stepID := range prerequisite.StepIDs {
+			if passedStepIDs[stepID] {
+				passedSteps++
+			}
+		}
+
+		requiredSteps := prerequisite.MinPassedSteps
+		if requiredSteps == 0 {
+			requiredSteps = len(prerequisite.StepIDs)
+		}
+
+		if passedSteps < requiredSteps {
+			unmetPrerequisites = append(unmetPrerequisites, prerequisite)
+		}
+	}
+
+	return unmetPrerequisites
+}
+
+// WithLockedSteps returns a copy of the course with every step locked whose prerequisites are not met.
+func (c Course) WithLockedSteps(passedQuizIDs map[string]bool) Course {
+	steps := make([]Step, len(c.Steps))
+	for i, step := range c.Steps {
+		step.Locked = len(c.GetUnmetPrerequisites(step, passedQuizIDs)) > 0
+		steps[i] = step
+	}
+
+	courseWithLockedSteps := c
+	courseWithLockedSteps.Steps = steps
+
+	return courseWithLockedSteps
+}
+
+// WithQuestionRatings returns a copy of the course with the aggregated rating of every rated question.
+func (c Course) WithQuestionRatings(aggregatedRatings map[string]questionrating.AggregatedRating) Course {
+	steps := make([]Step, len(c.Steps))
+	for i, step := range c.Steps {
+		quizzes := make([]StepQuiz, len(step.Quizzes))
+		for j, quiz := range step.Quizzes {
+			questions := make([]QuizQuestion, len(quiz.Questions))
+			for k, question := range quiz.Questions {
+				aggregatedRating := aggregatedRatings[question.ID]
+				question.Rating = aggregatedRating.Average
+				question.RatingCount = aggregatedRating.Count
+				questions[k] = question
+			}
+			quiz.Questions = questions
+			quizzes[j] = quiz
+		}
+		step.Quizzes = quizzes
+		steps[i] = step
+	}
+
+	courseWithQuestionRatings := c
+	courseWithQuestionRatings.Steps = steps
+
+	return courseWithQuestionRatings
+}
+
+// WithQuestionStats returns a copy of the course with the observed difficulty of every answered question.
+func (c Course) WithQuestionStats(questionStats map[string]questionstats.QuestionStats) Course {
+	steps := make([]Step, len(c.Steps))
+	for i, step := range c.Steps {
+		quizzes := make([]StepQuiz, len(step.Quizzes))
+		for j, quiz := range step.Quizzes {
+			questions := make([]QuizQuestion, len(quiz.Questions))
+			for k, question := range quiz.Questions {
+				stats := questionStats[question.ID]
+				question.AnswerCount = stats.AnswerCount
+				question.CorrectRatio = stats.CorrectRatio
+				question.AverageTimeSecs = stats.AverageTimeSecs
+				question.MedianTimeSecs = stats.MedianTimeSecs
+				question.P90TimeSecs = stats.P90TimeSecs
+				questions[k] = question
+			}
+			quiz.Questions = questions
+			quizzes[j] = quiz
+		}
+		step.Quizzes = quizzes
+		steps[i] = step
+	}
+
+	courseWithQuestionStats := c
+	courseWithQuestionStats.Steps = steps
+
+	return courseWithQuestionStats
+}
@@ -0,0 +1,102 @@
+package course_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/questionrating"
+	"testing"
+)
+
+func TestCourse_GetUnmetPrerequisites_RequiresAllStepsByDefault(t *testing.T) {
+	c := createCourseWithPrerequisite(course.Prerequisite{StepIDs: []string{"step-1", "step-2"}})
+
+	unmetPrerequisites := c.GetUnmetPrerequisites(c.Steps[2], map[string]bool{"quiz-1": true})
+	if len(unmetPrerequisites) != 1 {
+		t.Fatalf("expected 1 unmet prerequisite, got %v", unmetPrerequisites)
+	}
+
+	unmetPrerequisites = c.GetUnmetPrerequisites(c.Steps[2], map[string]bool{"quiz-1": true, "quiz-2": true})
+	if len(unmetPrerequisites) != 0 {
+		t.Fatalf("expected all prerequisites to be met, got %v", unmetPrerequisites)
+	}
+}
+
+func TestCourse_GetUnmetPrerequisites_RequiresMinPassedSteps(t *testing.T) {
+	c := createCourseWithPrerequisite(course.Prerequisite{StepIDs: []string{"step-1", "step-2"}, MinPassedSteps: 1})
+
+	unmetPrerequisites := c.GetUnmetPrerequisites(c.Steps[2], map[string]bool{})
+	if len(unmetPrerequisites) != 1 {
+		t.Fatalf("expected 1 unmet prerequisite, got %v", unmetPrerequisites)
+	}
+
+	unmetPrerequisites = c.GetUnmetPrerequisites(c.Steps[2], map[string]bool{"quiz-2": true})
+	if len(unmetPrerequisites) != 0 {
+		t.Fatalf("expected all prerequisites to be met, got %v", unmetPrerequisites)
+	}
+}
+
+func TestCourse_WithLockedSteps_LocksStepsWithUnmetPrerequisites(t *testing.T) {
+	c := createCourseWithPrerequisite(course.Prerequisite{StepIDs: []string{"step-1"}})
+
+	lockedCourse := c.WithLockedSteps(map[string]bool{})
+	if lockedCourse.Steps[0].Locked || lockedCourse.Steps[1].Locked || !lockedCourse.Steps[2].Locked {
+		t.Fatalf("expected only the last step to be locked, got %v", lockedCourse.Steps)
+	}
+
+	if c.Steps[2].Locked {
+		t.Fatalf("expected the course not to be modified")
+	}
+
+	unlockedCourse := c.WithLockedSteps(map[string]bool{"quiz-1": true})
+	if unlockedCourse.Steps[2].Locked {
+		t.Fatalf("expected the last step to be unlocked")
+	}
+}
+
+func createCourseWithPrerequisite(prerequisite course.Prerequisite) course.Course {
+	return course.Course{
+		ID: "course",
+		Steps: []course.Step{
+			{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz-1"}}},
+			{ID: "step-2", Quizzes: []course.StepQuiz{{ID: "quiz-2"}}},
+			{ID: "step-3", Quizzes: []course.StepQuiz{{ID: "quiz-3"}}, Prerequisites: []course.Prerequisite{prerequisite}},
+		},
+	}
+}
+
+func TestCourse_IsPassed_RequiresEveryStep(t *testing.T) {
+	c := createCourseWithPrerequisite(course.Prerequisite{StepIDs: []string{"step-1"}})
+
+	if c.IsPassed(map[string]bool{"quiz-1": true, "quiz-2": true}) {
+		t.Fatalf("expected the course not to be passed with a step left")
+	}
+
+	if !c.IsPassed(map[string]bool{"quiz-1": true, "quiz-2": true, "quiz-3": true}) {
+		t.Fatalf("expected the course to be passed")
+	}
+}
+
+func TestCourse_WithQuestionRatings_SetsRatingsOfRatedQuestions(t *testing.T) {
+	c := course.Course{
+		ID: "course",
+		Steps: []course.Step{
+			{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz-1", Questions: []course.QuizQuestion{{ID: "rated"}, {ID: "unrated"}}}}},
+		},
+	}
+
+	ratedCourse := c.WithQuestionRatings(map[string]questionrating.AggregatedRating{
+		"rated": {QuestionID: "rated", Average: 4.5, Count: 2},
+	})
+
+	questions := ratedCourse.Steps[0].Quizzes[0].Questions
+	if questions[0].Rating != 4.5 || questions[0].RatingCount != 2 {
+		t.Fatalf("expected rating 4.5 from 2 ratings, got %v", questions[0])
+	}
+
+	if questions[1].Rating != 0 || questions[1].RatingCount != 0 {
+		t.Fatalf("expected no rating for the unrated question, got %v", questions[1])
+	}
+
+	if c.Steps[0].Quizzes[0].Questions[0].RatingCount != 0 {
+		t.Fatalf("expected the course not to be modified")
+	}
+}
@@ -7,4 +7,25 @@ type QuizQuestion struct {
 	Answers     []QuizAnswer
 	Rating      float64
 	RatingCount int
+
+	// AnswerCount, CorrectRatio and the times are observed from the latest final answers in ended attempts of all
+	// participants, they show how difficult the question is in practice.
+	AnswerCount     int
+	CorrectRatio    float64
+	AverageTimeSecs float64
+	MedianTimeSecs  float64
+	P90TimeSecs     float64
+
+	// Hints are revealed one after another on request, every used hint reduces the score of the question.
+	Hints []string
+}
+
+func (q QuizQuestion) FindAnswer(answerID string) (QuizAnswer, bool) {
+	for _, answer := range q.Answers {
+		if answer.ID == answerID {
+			return answer, true
+		}
+	}
+
+	return QuizAnswer{}, false
 }
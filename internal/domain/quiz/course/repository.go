@@ -4,6 +4,16 @@ import "errors"
 
 var ErrCourseNotFound = errors.New("course not found")
 
+var ErrQuizNotFound = errors.New("quiz not found")
+
+var ErrQuestionNotFound = errors.New("question not found")
+
 type Repository interface {
 	FindByID(id string) (Course, error)
+
+	// FindQuizByID searches the quiz in all courses and returns ErrQuizNotFound if no course contains it.
+	FindQuizByID(quizID string) (StepQuiz, error)
+
+	// FindByQuizID returns the course containing the quiz and ErrQuizNotFound if no course contains it.
+	FindByQuizID(quizID string) (Course, error)
 }
@@ -4,4 +4,27 @@ type Step struct {
 	ID      string
 	Quizzes []StepQuiz
 	Name    string
+
+	// Prerequisites have to be met before a quiz of the step can be started.
+	Prerequisites []Prerequisite
+
+	// Locked is set for the participant the course is shown to, see Course.WithLockedSteps.
+	Locked bool
+}
+
+// Prerequisite requires a participant to pass MinPassedSteps of the given steps, 0 means all steps have to be passed.
+type Prerequisite struct {
+	StepIDs        []string
+	MinPassedSteps int
+}
+
+// IsPassed returns true if every quiz of the step has been passed.
+func (s Step) IsPassed(passedQuizIDs map[string]bool) bool {
+	for _, quiz := range s.Quizzes {
+		if !passedQuizIDs[quiz.ID] {
+			return false
+		}
+	}
+
+	return true
 }
@@ -1,6 +1,217 @@
 package course
 
+import (
+	"math/rand"
+	"slices"
+	"sort"
+)
+
 type StepQuiz struct {
 	ID        string
 	Questions []QuizQuestion
+
+	// DurationMins limits the time a participant has for a single attempt, 0 means no limit.
+	DurationMins int
+
+	// MaxAttempts limits the number of attempts per participant, 0 means unlimited attempts.
+	MaxAttempts int
+
+	// CooldownMins is the minimum time between a failed attempt and the next attempt.
+	CooldownMins int
+
+	// QuestionsPerAttempt turns the questions into a pool each attempt draws this number of questions from,
+	// 0 means every attempt contains all questions.
+	QuestionsPerAttempt int
+
+	// DifficultyQuotas is the number of questions per difficulty drawn before the rest of an attempt is drawn
+	// from the remaining pool.
+	DifficultyQuotas map[string]int
+
+	// Adaptive quizzes choose the difficulty of the next question by the answers of the participant so far.
+	Adaptive bool
+
+	// FeedbackDisabled hides the correctness and the explanation after every answer, e.g. for exams.
+	FeedbackDisabled bool
+
+	// HintPenalty is the share of the score of a question deducted per used hint, 0 means hints are free.
+	HintPenalty float64
+}
+
+func (q StepQuiz) FindQuestion(questionID string) (QuizQuestion, bool) {
+	for _, question := range q.Questions {
+		if question.ID == questionID {
+			return question, true
+		}
+	}
+
+	return QuizQuestion{}, false
+}
+
+func (q StepQuiz) IsQuestionPool() bool {
+	return q.QuestionsPerAttempt > 0 && q.QuestionsPerAttempt < len(q.Questions)
+}
+
+// DrawQuestionIDs draws the questions of an attempt from the pool, the same seed always draws the same questions.
+// The ids keep the order of the quiz definition, quizzes that are no pool return nil.
+func (q StepQuiz) DrawQuestionIDs(seed int64) []string {
+	if !q.IsQuestionPool() {
+		return nil
+	}
+
+	random := rand.New(rand.NewSource(seed))
+
+	candidateIndexes := random.Perm(len(q.Questions))
+	drawnIndexes := map[int]bool{}
+
+	difficulties := make([]string, 0, len(q.DifficultyQuotas))
+	for difficulty := range q.DifficultyQuotas {
+		difficulties = append(difficulties, difficulty)
+	}
+	sort.Strings(difficulties)
+
+	for _, difficulty := range difficulties {
+		drawnForDifficulty := 0
+		for _, candidateIndex := range candidateIndexes {
+			if drawnForDifficulty == q.DifficultyQuotas[difficulty] || len(drawnIndexes) == q.QuestionsPerAttempt {
+				break
+			}
+
+			if q.Questions[candidateIndex].Difficulty == difficulty {
+				drawnIndexes[candidateIndex] = true
+				drawnForDifficulty++
+			}
+		}
+	}
+
+	for _, candidateIndex := range candidateIndexes {
+		if len(drawnIndexes) == q.QuestionsPerAttempt {
+			break
+		}
+
+		drawnIndexes[candidateIndex] = true
+	}
+
+	var drawnQuestionIDs []string
+	for i, question := range q.Questions {
+		if drawnIndexes[i] {
+			drawnQuestionIDs = append(drawnQuestionIDs, question.ID)
+		}
+	}
+
+	return drawnQuestionIDs
+}
+
+// WithQuestions returns a copy of the quiz that only contains the given questions, nil keeps all questions.
+func (q StepQuiz) WithQuestions(questionIDs []string) StepQuiz {
+	if questionIDs == nil {
+		return q
+	}
+
+	includedQuestionIDs := map[string]bool{}
+	for _, questionID := range questionIDs {
+		includedQuestionIDs[questionID] = true
+	}
+
+	var questions []QuizQuestion
+	for _, question := range q.Questions {
+		if includedQuestionIDs[question.ID] {
+			questions = append(questions, question)
+		}
+	}
+
+	filteredQuiz := q
+	filteredQuiz.Questions = questions
+
+	return filteredQuiz
+}
+
+// Shuffled returns a copy of the quiz with questions and answers ordered by the seed. The same seed always
+// results in the same order, a seed of 0 keeps the original order.
+func (q StepQuiz) Shuffled(seed int64) StepQuiz {
+	if seed == 0 {
+		return q
+	}
+
+	random := rand.New(rand.NewSource(seed))
+
+	questions := make([]QuizQuestion, len(q.Questions))
+	copy(questions, q.Questions)
+	random.Shuffle(len(questions), func(i, j int) {
+		questions[i], questions[j] = questions[j], questions[i]
+	})
+
+	for i := range questions {
+		answers := make([]QuizAnswer, len(questions[i].Answers))
+		copy(answers, questions[i].Answers)
+		random.Shuffle(len(answers), func(i, j int) {
+			answers[i], answers[j] = answers[j], answers[i]
+		})
+		questions[i].Answers = answers
+	}
+
+	shuffledQuiz := q
+	shuffledQuiz.Questions = questions
+
+	return shuffledQuiz
+}
+
+// GetOrder returns the question ids and the answer ids per question in the order of the quiz, nil for a quiz without
+// questions.
+func (q StepQuiz) GetOrder() ([]string, map[string][]string) {
+	if len(q.Questions) == 0 {
+		return nil, nil
+	}
+
+	questionIDs := make([]string, 0, len(q.Questions))
+	answerIDs := map[string][]string{}
+	for _, question := range q.Questions {
+		questionIDs = append(questionIDs, question.ID)
+		for _, answer := range question.Answers {
+			answerIDs[question.ID] = append(answerIDs[question.ID], answer.ID)
+		}
+	}
+
+	return questionIDs, answerIDs
+}
+
+// Ordered returns a copy of the quiz with questions and answers in the order of the ids, questions and answers missing
+// in the ids follow in the order of the definition.
+func (q StepQuiz) Ordered(questionIDs []string, answerIDs map[string][]string) StepQuiz {
+	questions := orderByIDs(q.Questions, questionIDs, func(question QuizQuestion) string {
+		return question.ID
+	})
+
+	for i := range questions {
+		questions[i].Answers = orderByIDs(questions[i].Answers, answerIDs[questions[i].ID], func(answer QuizAnswer) string {
+			return answer.ID
+		})
+	}
+
+	orderedQuiz := q
+	orderedQuiz.Questions = questions
+
+	return orderedQuiz
+}
+
+func orderByIDs[T any](items []T, ids []string, getID func(T) string) []T {
+	positions := make(map[string]int, len(ids))
+	for i, id := range ids {
+		positions[id] = i
+	}
+
+	getPosition := func(item T) int {
+		position, ok := positions[getID(item)]
+		if !ok {
+			return len(ids)
+		}
+
+		return position
+	}
+
+	orderedItems := slices.Clone(items)
+	slices.SortStableFunc(orderedItems, func(a T, b T) int {
+		return getPosition(a) - getPosition(b)
+	})
+
+	return orderedItems
 }
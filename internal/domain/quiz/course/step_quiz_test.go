@@ -0,0 +1,138 @@
+package course_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"reflect"
+	"testing"
+)
+
+func TestStepQuiz_Shuffled_IsDeterministicForSeed(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+
+	first := q.Shuffled(42)
+	second := q.Shuffled(42)
+
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("expected the same order for the same seed")
+	}
+
+	if reflect.DeepEqual(first.Questions, q.Questions) {
+		t.Fatalf("expected a different question order than the quiz definition")
+	}
+}
+
+func TestStepQuiz_Shuffled_KeepsOrderForSeedZero(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+
+	if !reflect.DeepEqual(q.Shuffled(0), q) {
+		t.Fatalf("expected the order of the quiz definition for seed 0")
+	}
+}
+
+func TestStepQuiz_Shuffled_DoesNotModifyQuiz(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+	firstQuestionID := q.Questions[0].ID
+	firstAnswerID := q.Questions[0].Answers[0].ID
+
+	q.Shuffled(42)
+
+	if q.Questions[0].ID != firstQuestionID || q.Questions[0].Answers[0].ID != firstAnswerID {
+		t.Fatalf("expected the quiz definition to keep its order")
+	}
+}
+
+func TestStepQuiz_Ordered_RestoresOrderOfShuffledQuiz(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+	shuffled := q.Shuffled(42)
+
+	questionIDs, answerIDs := shuffled.GetOrder()
+
+	if !reflect.DeepEqual(q.Ordered(questionIDs, answerIDs), shuffled) {
+		t.Fatalf("expected the order of the shuffled quiz")
+	}
+}
+
+func TestStepQuiz_Ordered_AppendsQuestionsAndAnswersMissingInOrder(t *testing.T) {
+	q := createStepQuizWithQuestions(3)
+
+	ordered := q.Ordered([]string{"c", "removed", "a"}, map[string][]string{"c": {"4", "3"}})
+
+	var questionIDs []string
+	for _, question := range ordered.Questions {
+		questionIDs = append(questionIDs, question.ID)
+	}
+	if !reflect.DeepEqual(questionIDs, []string{"c", "a", "b"}) {
+		t.Fatalf("expected questions c, a, b, got %v", questionIDs)
+	}
+
+	var answerIDs []string
+	for _, answer := range ordered.Questions[0].Answers {
+		answerIDs = append(answerIDs, answer.ID)
+	}
+	if !reflect.DeepEqual(answerIDs, []string{"4", "3", "1", "2"}) {
+		t.Fatalf("expected answers 4, 3, 1, 2, got %v", answerIDs)
+	}
+}
+
+func TestStepQuiz_DrawQuestionIDs_DrawsQuestionsPerAttempt(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+	q.QuestionsPerAttempt = 5
+
+	drawnQuestionIDs := q.DrawQuestionIDs(42)
+
+	if len(drawnQuestionIDs) != 5 {
+		t.Fatalf("expected 5 drawn questions, got %d", len(drawnQuestionIDs))
+	}
+
+	if !reflect.DeepEqual(drawnQuestionIDs, q.DrawQuestionIDs(42)) {
+		t.Fatalf("expected the same questions for the same seed")
+	}
+}
+
+func TestStepQuiz_DrawQuestionIDs_RespectsDifficultyQuotas(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+	for i := range q.Questions {
+		q.Questions[i].Difficulty = "easy"
+	}
+	q.Questions[3].Difficulty = "hard"
+	q.Questions[17].Difficulty = "hard"
+	q.QuestionsPerAttempt = 4
+	q.DifficultyQuotas = map[string]int{"hard": 2}
+
+	for seed := int64(1); seed < 20; seed++ {
+		drawnQuiz := q.WithQuestions(q.DrawQuestionIDs(seed))
+
+		hardQuestions := 0
+		for _, question := range drawnQuiz.Questions {
+			if question.Difficulty == "hard" {
+				hardQuestions++
+			}
+		}
+
+		if len(drawnQuiz.Questions) != 4 || hardQuestions != 2 {
+			t.Fatalf("expected 4 questions with 2 hard questions, got %d with %d hard questions", len(drawnQuiz.Questions), hardQuestions)
+		}
+	}
+}
+
+func TestStepQuiz_DrawQuestionIDs_ReturnsNilWithoutPool(t *testing.T) {
+	q := createStepQuizWithQuestions(20)
+
+	if q.DrawQuestionIDs(42) != nil {
+		t.Fatalf("expected no drawn questions for a quiz without pool")
+	}
+}
+
+func createStepQuizWithQuestions(questionCount int) course.StepQuiz {
+	var questions []course.QuizQuestion
+	for i := 0; i < questionCount; i++ {
+		questions = append(questions, course.QuizQuestion{
+			ID: string(rune('a' + i)),
+			Answers: []course.QuizAnswer{
+				{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"},
+			},
+		})
+	}
+
+	return course.StepQuiz{ID: "quiz", Questions: questions}
+}
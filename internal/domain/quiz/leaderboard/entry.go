@@ -0,0 +1,68 @@
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrUnknownPeriod = errors.New("unknown leaderboard period")
+var ErrUnknownMetric = errors.New("unknown leaderboard metric")
+
+// AllTimePeriod identifies the leaderboard over the whole lifetime of a course.
+const AllTimePeriod = "all-time"
+
+// WeeklyPeriod selects the leaderboard of the current ISO week, e.g. 2023-W45.
+const WeeklyPeriod = "weekly"
+
+// Metric decides by which score the participants are ranked.
+type Metric string
+
+const (
+	MetricExperiencePoints Metric = "xp"
+	MetricQuizzesPassed    Metric = "quizzesPassed"
+)
+
+// Entry is the score of a participant in the leaderboard of a course for a single period.
+type Entry struct {
+	CourseID      string
+	Period        string
+	ParticipantID string
+	XP            int
+	QuizzesPassed int
+}
+
+// GetWeekPeriod returns the period of the ISO week in UTC the given time belongs to.
+func GetWeekPeriod(t time.Time) string {
+	year, week := t.UTC().ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// ParsePeriod resolves the requested period, a weekly leaderboard is always the one of the current week.
+func ParsePeriod(period string, now time.Time) (string, error) {
+	switch period {
+	case AllTimePeriod:
+		return AllTimePeriod, nil
+	case WeeklyPeriod:
+		return GetWeekPeriod(now), nil
+	default:
+		return "", fmt.Errorf("period '%v': %w", period, ErrUnknownPeriod)
+	}
+}
+
+func ParseMetric(metric string) (Metric, error) {
+	switch Metric(metric) {
+	case MetricExperiencePoints, MetricQuizzesPassed:
+		return Metric(metric), nil
+	default:
+		return "", fmt.Errorf("metric '%v': %w", metric, ErrUnknownMetric)
+	}
+}
+
+func (e Entry) score(metric Metric) int {
+	if metric == MetricQuizzesPassed {
+		return e.QuizzesPassed
+	}
+
+	return e.XP
+}
@@ -0,0 +1,82 @@
+package leaderboard
+
+import (
+	"sort"
+)
+
+// DefaultPageSize is used if no page size is requested, MaxPageSize limits the size of a single page.
+const DefaultPageSize = 20
+const MaxPageSize = 100
+
+type RankedEntry struct {
+	// Rank is shared by participants with the same score, the next rank skips the shared ones (1, 2, 2, 4)
+	Rank          int
+	ParticipantID string
+	Score         int
+}
+
+type Ranking struct {
+	CourseID     string
+	Period       string
+	Metric       Metric
+	Page         int
+	PageSize     int
+	TotalEntries int
+	Entries      []RankedEntry
+}
+
+// NewRanking ranks the entries by the score of the metric and returns the requested page, pages start at 1.
+// Entries without any score are not ranked.
+func NewRanking(courseID string, period string, metric Metric, entries []Entry, page int, pageSize int) Ranking {
+	page = max(page, 1)
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	var scoredEntries []Entry
+	for _, entry := range entries {
+		if entry.score(metric) > 0 {
+			scoredEntries = append(scoredEntries, entry)
+		}
+	}
+
+	sort.SliceStable(scoredEntries, func(i, j int) bool {
+		if scoredEntries[i].score(metric) != scoredEntries[j].score(metric) {
+			return scoredEntries[i].score(metric) > scoredEntries[j].score(metric)
+		}
+
+		return scoredEntries[i].ParticipantID < scoredEntries[j].ParticipantID
+	})
+
+	rankedEntries := make([]RankedEntry, 0, len(scoredEntries))
+	for i, entry := range scoredEntries {
+		rank := i + 1
+		if i > 0 && entry.score(metric) == rankedEntries[i-1].Score {
+			rank = rankedEntries[i-1].Rank
+		}
+
+		rankedEntries = append(rankedEntries, RankedEntry{
+			Rank:          rank,
+			ParticipantID: entry.ParticipantID,
+			Score:         entry.score(metric),
+		})
+	}
+
+	// pages after the last entry are empty, the offset is only calculated within the entries so it can not overflow
+	start := len(rankedEntries)
+	if page-1 <= len(rankedEntries)/pageSize {
+		start = min((page-1)*pageSize, len(rankedEntries))
+	}
+	end := min(start+pageSize, len(rankedEntries))
+
+	return Ranking{
+		CourseID:     courseID,
+		Period:       period,
+		Metric:       metric,
+		Page:         page,
+		PageSize:     pageSize,
+		TotalEntries: len(rankedEntries),
+		Entries:      rankedEntries[start:end],
+	}
+}
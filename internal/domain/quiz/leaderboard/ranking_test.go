@@ -0,0 +1,77 @@
+package leaderboard_test
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"math"
+	"testing"
+	"time"
+)
+
+var entries = []leaderboard.Entry{
+	{ParticipantID: "alice", XP: 30, QuizzesPassed: 1},
+	{ParticipantID: "bob", XP: 50, QuizzesPassed: 1},
+	{ParticipantID: "carol", XP: 30, QuizzesPassed: 3},
+	{ParticipantID: "dave", XP: 10, QuizzesPassed: 0},
+	{ParticipantID: "erin", XP: 0, QuizzesPassed: 0},
+}
+
+func TestNewRanking_RanksByMetricAndSharesRanksOnEqualScores(t *testing.T) {
+	ranking := leaderboard.NewRanking("course", leaderboard.AllTimePeriod, leaderboard.MetricExperiencePoints, entries, 1, 10)
+
+	expected := []leaderboard.RankedEntry{
+		{Rank: 1, ParticipantID: "bob", Score: 50},
+		{Rank: 2, ParticipantID: "alice", Score: 30},
+		{Rank: 2, ParticipantID: "carol", Score: 30},
+		{Rank: 4, ParticipantID: "dave", Score: 10},
+	}
+
+	if ranking.TotalEntries != 4 || len(ranking.Entries) != len(expected) {
+		t.Fatalf("expected 4 ranked entries without the one without score, got %v", ranking.Entries)
+	}
+
+	for i, rankedEntry := range ranking.Entries {
+		if rankedEntry != expected[i] {
+			t.Fatalf("expected %v at position %d, got %v", expected[i], i, rankedEntry)
+		}
+	}
+
+	quizRanking := leaderboard.NewRanking("course", leaderboard.AllTimePeriod, leaderboard.MetricQuizzesPassed, entries, 1, 10)
+	if quizRanking.Entries[0].ParticipantID != "carol" || quizRanking.TotalEntries != 3 {
+		t.Fatalf("expected carol to lead the passed quizzes of 3 ranked participants, got %v", quizRanking.Entries)
+	}
+}
+
+func TestNewRanking_ReturnsRequestedPage(t *testing.T) {
+	ranking := leaderboard.NewRanking("course", leaderboard.AllTimePeriod, leaderboard.MetricExperiencePoints, entries, 2, 3)
+
+	if len(ranking.Entries) != 1 || ranking.Entries[0].ParticipantID != "dave" || ranking.Entries[0].Rank != 4 {
+		t.Fatalf("expected dave with rank 4 on the second page, got %v", ranking.Entries)
+	}
+
+	emptyRanking := leaderboard.NewRanking("course", leaderboard.AllTimePeriod, leaderboard.MetricExperiencePoints, entries, 5, 3)
+	if len(emptyRanking.Entries) != 0 || emptyRanking.TotalEntries != 4 {
+		t.Fatalf("expected an empty page after the last entry, got %v", emptyRanking.Entries)
+	}
+}
+
+func TestNewRanking_HugePage_ReturnsEmptyPage(t *testing.T) {
+	ranking := leaderboard.NewRanking("course", leaderboard.AllTimePeriod, leaderboard.MetricExperiencePoints, entries, math.MaxInt, 20)
+
+	if len(ranking.Entries) != 0 {
+		t.Fatalf("expected an empty page for a huge page number, got %v", ranking.Entries)
+	}
+}
+
+func TestParsePeriod_ResolvesWeeklyToCurrentWeek(t *testing.T) {
+	period := err.PanicIfError1(leaderboard.ParsePeriod(leaderboard.WeeklyPeriod, time.Date(2023, 11, 8, 12, 0, 0, 0, time.UTC)))
+	if period != "2023-W45" {
+		t.Fatalf("expected 2023-W45, got %v", period)
+	}
+
+	_, parseErr := leaderboard.ParsePeriod("monthly", time.Now())
+	if !errors.Is(parseErr, leaderboard.ErrUnknownPeriod) {
+		t.Fatalf("expected unknown period error, got %v", parseErr)
+	}
+}
@@ -0,0 +1,12 @@
+package leaderboard
+
+type Repository interface {
+	// FindEntries returns the entries of every participant in the leaderboard of the course for the period.
+	FindEntries(courseID string, period string) ([]Entry, error)
+
+	// StoreEntries creates or replaces the entries.
+	StoreEntries(entries []Entry) error
+
+	// DeleteEntries removes the entries, entries that do not exist are ignored.
+	DeleteEntries(entries []Entry) error
+}
@@ -0,0 +1,21 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+	"time"
+)
+
+// QuestionIssueQueued adds a report of a participant to the moderation queue.
+type QuestionIssueQueued struct {
+	ReportID      string
+	ParticipantID string
+	QuizID        string
+	QuestionID    string
+	Category      string
+	Text          string
+	ReportedAt    time.Time
+	eventsource.EventBase
+}
+
+var QuestionIssueQueuedTypeName = reflect.TypeOf(QuestionIssueQueued{}).Name()
@@ -0,0 +1,15 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuestionIssueRejected closes a report without a content change.
+type QuestionIssueRejected struct {
+	ReportID string
+	Reason   string
+	eventsource.EventBase
+}
+
+var QuestionIssueRejectedTypeName = reflect.TypeOf(QuestionIssueRejected{}).Name()
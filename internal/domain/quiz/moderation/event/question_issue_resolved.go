@@ -0,0 +1,15 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuestionIssueResolved closes a report that was fixed by the content version.
+type QuestionIssueResolved struct {
+	ReportID       string
+	ContentVersion string
+	eventsource.EventBase
+}
+
+var QuestionIssueResolvedTypeName = reflect.TypeOf(QuestionIssueResolved{}).Name()
@@ -0,0 +1,184 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/moderation/event"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var ErrReportNotFound = errors.New("question issue report not found")
+
+var ErrReportAlreadyDecided = errors.New("question issue report is already resolved or rejected")
+
+var ErrMissingContentVersion = errors.New("a resolved report requires the content version that fixed it")
+
+// QueueID is the aggregate id of the moderation queue, there is a single queue for all courses.
+const QueueID = "moderation-queue"
+
+// Queue collects the question issues reported by participants until a moderator resolves or rejects them.
+type Queue struct {
+	reports map[string]*Report
+
+	eventsource.AggregateRoot
+}
+
+func NewQueue() Queue {
+	return Queue{
+		reports: map[string]*Report{},
+	}
+}
+
+func NewQueueFromEvents(events []eventsource.Event, isPersisted bool) (Queue, error) {
+	q := NewQueue()
+
+	for _, e := range events {
+		err := q.apply(e, isPersisted)
+		if err != nil {
+			return Queue{}, err
+		}
+	}
+
+	return q, nil
+}
+
+func (q *Queue) apply(eventToApply eventsource.Event, isPersisted bool) error {
+	switch e := eventToApply.(type) {
+
+	case event.QuestionIssueQueued:
+		if _, ok := q.reports[e.ReportID]; ok {
+			return fmt.Errorf("report %v is already queued", e.ReportID)
+		}
+
+		q.reports[e.ReportID] = &Report{
+			ID:            e.ReportID,
+			ParticipantID: e.ParticipantID,
+			QuizID:        e.QuizID,
+			QuestionID:    e.QuestionID,
+			Category:      e.Category,
+			Text:          e.Text,
+			ReportedAt:    e.ReportedAt,
+			Status:        StatusOpen,
+		}
+
+	case event.QuestionIssueResolved:
+		report, err := q.findOpenReport(e.ReportID)
+		if err != nil {
+			return err
+		}
+
+		if e.ContentVersion == "" {
+			return fmt.Errorf("can not resolve report %v: %w", e.ReportID, ErrMissingContentVersion)
+		}
+
+		report.Status = StatusResolved
+		report.DecidedAt = e.CreatedAt
+		report.ContentVersion = e.ContentVersion
+
+	case event.QuestionIssueRejected:
+		report, err := q.findOpenReport(e.ReportID)
+		if err != nil {
+			return err
+		}
+
+		report.Status = StatusRejected
+		report.DecidedAt = e.CreatedAt
+		report.RejectReason = e.Reason
+
+	default:
+		panic(fmt.Sprintf("unknown event type %s", reflect.TypeOf(eventToApply)))
+	}
+
+	q.AppendEvent(eventToApply, isPersisted)
+
+	return nil
+}
+
+func (q *Queue) findOpenReport(reportID string) (*Report, error) {
+	report, ok := q.reports[reportID]
+	if !ok {
+		return nil, fmt.Errorf("report %v: %w", reportID, ErrReportNotFound)
+	}
+
+	if report.Status != StatusOpen {
+		return nil, fmt.Errorf("report %v: %w", reportID, ErrReportAlreadyDecided)
+	}
+
+	return report, nil
+}
+
+// Enqueue adds the report to the queue, reports that are already queued are skipped. Returns true if the report
+// was added.
+func (q *Queue) Enqueue(report Report) (bool, error) {
+	if _, ok := q.reports[report.ID]; ok {
+		return false, nil
+	}
+
+	questionIssueQueuedEvent := event.QuestionIssueQueued{
+		ReportID:      report.ID,
+		ParticipantID: report.ParticipantID,
+		QuizID:        report.QuizID,
+		QuestionID:    report.QuestionID,
+		Category:      report.Category,
+		Text:          report.Text,
+		ReportedAt:    report.ReportedAt,
+		EventBase:     q.createEventBaseEvent(),
+	}
+
+	err := q.apply(questionIssueQueuedEvent, false)
+
+	return err == nil, err
+}
+
+// Resolve closes the report as fixed by the content version, e.g. the commit of the corrected quiz JSON.
+func (q *Queue) Resolve(reportID string, contentVersion string) error {
+	questionIssueResolvedEvent := event.QuestionIssueResolved{
+		ReportID:       reportID,
+		ContentVersion: contentVersion,
+		EventBase:      q.createEventBaseEvent(),
+	}
+
+	return q.apply(questionIssueResolvedEvent, false)
+}
+
+// Reject closes the report without a content change.
+func (q *Queue) Reject(reportID string, reason string) error {
+	questionIssueRejectedEvent := event.QuestionIssueRejected{
+		ReportID:  reportID,
+		Reason:    reason,
+		EventBase: q.createEventBaseEvent(),
+	}
+
+	return q.apply(questionIssueRejectedEvent, false)
+}
+
+// GetReports returns the reports with the status, the oldest report first.
+func (q *Queue) GetReports(status Status) []Report {
+	reports := []Report{}
+	for _, report := range q.reports {
+		if report.Status == status {
+			reports = append(reports, *report)
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if !reports[i].ReportedAt.Equal(reports[j].ReportedAt) {
+			return reports[i].ReportedAt.Before(reports[j].ReportedAt)
+		}
+
+		return reports[i].ID < reports[j].ID
+	})
+
+	return reports
+}
+
+func (q *Queue) createEventBaseEvent() eventsource.EventBase {
+	return eventsource.EventBase{
+		AggregateID: QueueID,
+		Version:     q.GetCurrentVersion(),
+		CreatedAt:   time.Now(),
+	}
+}
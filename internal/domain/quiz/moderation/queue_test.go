@@ -0,0 +1,118 @@
+package moderation_test
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"testing"
+	"time"
+)
+
+var reportedAt = time.Date(2023, 11, 8, 12, 0, 0, 0, time.UTC)
+
+func TestQueue_Enqueue_SkipsQueuedReports(t *testing.T) {
+	q := moderation.NewQueue()
+
+	added := err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+	addedAgain := err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+
+	if !added || addedAgain {
+		t.Fatalf("expected the report to be added only once")
+	}
+
+	if len(q.GetReports(moderation.StatusOpen)) != 1 {
+		t.Fatalf("expected a single open report, got %v", q.GetReports(moderation.StatusOpen))
+	}
+}
+
+func TestQueue_GetReports_OldestFirst(t *testing.T) {
+	q := moderation.NewQueue()
+	err.PanicIfError1(q.Enqueue(createReport("report-2", reportedAt.Add(time.Hour))))
+	err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+
+	reports := q.GetReports(moderation.StatusOpen)
+	if reports[0].ID != "report-1" || reports[1].ID != "report-2" {
+		t.Fatalf("expected the oldest report first, got %v", reports)
+	}
+}
+
+func TestQueue_Resolve_LinksContentVersion(t *testing.T) {
+	q := moderation.NewQueue()
+	err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+
+	err.PanicIfError(q.Resolve("report-1", "5f2c1e9"))
+
+	resolvedReports := q.GetReports(moderation.StatusResolved)
+	if len(resolvedReports) != 1 || resolvedReports[0].ContentVersion != "5f2c1e9" || resolvedReports[0].DecidedAt.IsZero() {
+		t.Fatalf("expected a resolved report with content version 5f2c1e9, got %v", resolvedReports)
+	}
+
+	if len(q.GetReports(moderation.StatusOpen)) != 0 {
+		t.Fatalf("expected no open reports")
+	}
+}
+
+func TestQueue_Resolve_RequiresContentVersion(t *testing.T) {
+	q := moderation.NewQueue()
+	err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+
+	resolveErr := q.Resolve("report-1", "")
+	if !errors.Is(resolveErr, moderation.ErrMissingContentVersion) {
+		t.Fatalf("expected missing content version error, got %v", resolveErr)
+	}
+}
+
+func TestQueue_Reject_FailsForDecidedOrUnknownReport(t *testing.T) {
+	q := moderation.NewQueue()
+	err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+	err.PanicIfError(q.Reject("report-1", "works as intended"))
+
+	rejectErr := q.Reject("report-1", "works as intended")
+	if !errors.Is(rejectErr, moderation.ErrReportAlreadyDecided) {
+		t.Fatalf("expected already decided error, got %v", rejectErr)
+	}
+
+	rejectErr = q.Reject("unknown", "works as intended")
+	if !errors.Is(rejectErr, moderation.ErrReportNotFound) {
+		t.Fatalf("expected report not found error, got %v", rejectErr)
+	}
+}
+
+func TestQueue_NewQueueFromEvents_RestoresReports(t *testing.T) {
+	q := moderation.NewQueue()
+	err.PanicIfError1(q.Enqueue(createReport("report-1", reportedAt)))
+	err.PanicIfError(q.Reject("report-1", "works as intended"))
+
+	restoredQueue := err.PanicIfError1(moderation.NewQueueFromEvents(q.GetEvents(), true))
+
+	rejectedReports := restoredQueue.GetReports(moderation.StatusRejected)
+	if len(rejectedReports) != 1 || rejectedReports[0].RejectReason != "works as intended" {
+		t.Fatalf("expected the rejected report to be restored, got %v", rejectedReports)
+	}
+}
+
+func TestValidateIssue_RequiresKnownCategoryAndText(t *testing.T) {
+	if !errors.Is(moderation.ValidateIssue("spam", "text"), moderation.ErrUnknownIssueCategory) {
+		t.Fatalf("expected unknown category error")
+	}
+
+	if !errors.Is(moderation.ValidateIssue(moderation.CategoryTypo, ""), moderation.ErrInvalidIssueText) {
+		t.Fatalf("expected invalid text error")
+	}
+
+	if moderation.ValidateIssue(moderation.CategoryTypo, "Missing letter") != nil {
+		t.Fatalf("expected a valid issue")
+	}
+}
+
+func createReport(reportID string, reportedAt time.Time) moderation.Report {
+	return moderation.Report{
+		ID:            reportID,
+		ParticipantID: "participant",
+		QuizID:        "quiz",
+		QuestionID:    "question",
+		Category:      moderation.CategoryTypo,
+		Text:          "Missing letter",
+		ReportedAt:    reportedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrUnknownIssueCategory = errors.New("unknown question issue category")
+
+var ErrInvalidIssueText = errors.New("question issue text must not be empty or longer than 2000 characters")
+
+var ErrUnknownStatus = errors.New("unknown question issue report status")
+
+const IssueTextMaxLength = 2000
+
+const (
+	CategoryWrongAnswer = "wrong-answer"
+	CategoryTypo        = "typo"
+	CategoryUnclear     = "unclear"
+	CategoryOther       = "other"
+)
+
+type Status string
+
+const (
+	StatusOpen     Status = "open"
+	StatusResolved Status = "resolved"
+	StatusRejected Status = "rejected"
+)
+
+// Report is a problem with a question reported by a participant.
+type Report struct {
+	ID            string
+	ParticipantID string
+	QuizID        string
+	QuestionID    string
+	Category      string
+	Text          string
+	ReportedAt    time.Time
+
+	Status    Status
+	DecidedAt time.Time
+
+	// ContentVersion is the version of the quiz content that fixed the problem, set once the report is resolved.
+	ContentVersion string
+
+	// RejectReason explains why a rejected report did not need a content change.
+	RejectReason string
+}
+
+// ValidateIssue returns an error if the category is unknown or the text is empty or too long.
+func ValidateIssue(category string, text string) error {
+	switch category {
+	case CategoryWrongAnswer, CategoryTypo, CategoryUnclear, CategoryOther:
+	default:
+		return fmt.Errorf("category '%v': %w", category, ErrUnknownIssueCategory)
+	}
+
+	if len(text) == 0 || len([]rune(text)) > IssueTextMaxLength {
+		return ErrInvalidIssueText
+	}
+
+	return nil
+}
+
+func ParseStatus(status string) (Status, error) {
+	switch Status(status) {
+	case StatusOpen, StatusResolved, StatusRejected:
+		return Status(status), nil
+	default:
+		return "", fmt.Errorf("status '%v': %w", status, ErrUnknownStatus)
+	}
+}
@@ -0,0 +1,12 @@
+package moderation
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+)
+
+type Repository interface {
+	// FindOrCreate returns the moderation queue or an empty queue if nothing was reported yet.
+	FindOrCreate() (Queue, error)
+
+	StoreEvents(events []eventsource.Event) error
+}
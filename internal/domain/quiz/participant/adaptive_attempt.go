@@ -0,0 +1,175 @@
+package participant
+
+import (
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"math"
+)
+
+var ErrAttemptNotAdaptive = errors.New("quiz attempt is not adaptive")
+
+var ErrNotNextAdaptiveQuestion = errors.New("question is not the next question of the adaptive attempt")
+
+var ErrAdaptiveAttemptNotSettled = errors.New("adaptive attempt needs further answers to settle the ability estimate")
+
+// QuizFinder finds the quiz definitions the ability of timed out adaptive attempts is estimated with.
+type QuizFinder interface {
+	FindQuizByID(quizID string) (course.StepQuiz, error)
+}
+
+// NextQuestion is the next question of an adaptive attempt. The attempt is finished once the ability
+// estimate settled or no questions are left.
+type NextQuestion struct {
+	Finished     bool
+	Question     course.QuizQuestion
+	AbilityScore float64
+}
+
+// GetNextAdaptiveQuestion chooses the unanswered question with the difficulty closest to the current ability
+// estimate of the latest attempt. Questions are considered in the order of the attempt, so the choice is deterministic.
+func (p *Participant) GetNextAdaptiveQuestion(quiz course.StepQuiz) (NextQuestion, error) {
+	quizAttempts, ok := p.quizAttempts[quiz.ID]
+	if !ok {
+		return NextQuestion{}, fmt.Errorf("quiz %v: %w", quiz.ID, ErrNoActiveAttempt)
+	}
+
+	lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+	if !lastQuizAttempt.adaptive {
+		return NextQuestion{}, fmt.Errorf("quiz %v: %w", quiz.ID, ErrAttemptNotAdaptive)
+	}
+
+	if lastQuizAttempt.completed {
+		return NextQuestion{Finished: true, AbilityScore: lastQuizAttempt.abilityScore}, nil
+	}
+
+	return lastQuizAttempt.nextAdaptiveQuestion(quiz), nil
+}
+
+// SelectAdaptiveQuizAnswer selects the answer of the question the adaptive attempt chose next. Answers to any other
+// question are rejected, otherwise the participant could steer the ability estimate by picking questions.
+func (p *Participant) SelectAdaptiveQuizAnswer(quiz course.StepQuiz, questionID string, answerID string, isCorrect bool, confidence string) error {
+	nextQuestion, err := p.GetNextAdaptiveQuestion(quiz)
+	if err != nil {
+		return err
+	}
+
+	if !nextQuestion.Finished && nextQuestion.Question.ID != questionID {
+		return fmt.Errorf("can not select an answer for question %v, next is %v: %w", questionID, nextQuestion.Question.ID, ErrNotNextAdaptiveQuestion)
+	}
+
+	return p.SelectQuizAnswerWithConfidence(quiz.ID, questionID, answerID, isCorrect, confidence)
+}
+
+// IsLatestAttemptAdaptive returns true if the latest attempt of the quiz is adaptive, retakes of adaptive quizzes are not.
+func (p *Participant) IsLatestAttemptAdaptive(quizID string) bool {
+	quizAttempts, ok := p.quizAttempts[quizID]
+
+	return ok && p.getLatestQuizAttempt(quizAttempts).adaptive
+}
+
+// FinishAdaptiveQuiz finishes the ongoing adaptive attempt with the final ability estimate. An attempt whose estimate
+// has not settled yet can not be finished, otherwise a single correct answer would pass the quiz.
+func (p *Participant) FinishAdaptiveQuiz(quiz course.StepQuiz) error {
+	nextQuestion, err := p.GetNextAdaptiveQuestion(quiz)
+	if err != nil {
+		return err
+	}
+
+	if !nextQuestion.Finished {
+		return fmt.Errorf("can not finish quiz %v, next is question %v: %w", quiz.ID, nextQuestion.Question.ID, ErrAdaptiveAttemptNotSettled)
+	}
+
+	finishedQuizEvent := event.FinishedQuiz{
+		EventBase:    p.createEventBaseEvent(),
+		QuizID:       quiz.ID,
+		AbilityScore: nextQuestion.AbilityScore,
+	}
+
+	return p.apply(finishedQuizEvent, false)
+}
+
+// FinishSettledAdaptiveQuiz finishes the ongoing adaptive attempt if no further question is needed and
+// returns if the attempt was finished.
+func (p *Participant) FinishSettledAdaptiveQuiz(quiz course.StepQuiz) (bool, error) {
+	nextQuestion, err := p.GetNextAdaptiveQuestion(quiz)
+	if err != nil {
+		return false, err
+	}
+
+	if !nextQuestion.Finished {
+		return false, nil
+	}
+
+	return true, p.FinishAdaptiveQuiz(quiz)
+}
+
+// getTimedOutAbilityScore estimates the final ability of the latest attempt of the quiz when it times out, attempts
+// that are not adaptive have no ability score.
+func (p *Participant) getTimedOutAbilityScore(quizID string, quizFinder QuizFinder) (float64, error) {
+	lastQuizAttempt := p.getLatestQuizAttempt(p.quizAttempts[quizID])
+	if !lastQuizAttempt.adaptive {
+		return 0, nil
+	}
+
+	quiz, err := quizFinder.FindQuizByID(quizID)
+	if errors.Is(err, course.ErrQuizNotFound) {
+		quiz = course.StepQuiz{ID: quizID}
+	} else if err != nil {
+		return 0, err
+	}
+
+	return lastQuizAttempt.estimateAbility(quiz).GetAbility(), nil
+}
+
+func (q quizAttempt) estimateAbility(quiz course.StepQuiz) *calculator.AbilityEstimate {
+	questionDifficulties := map[string]string{}
+	for _, question := range quiz.Questions {
+		questionDifficulties[question.ID] = question.Difficulty
+	}
+
+	abilityEstimate := calculator.NewAbilityEstimate()
+	for _, providedAnswer := range q.providedAnswers {
+		abilityEstimate.AddAnswer(questionDifficulties[providedAnswer.QuestionID], providedAnswer.IsCorrect)
+	}
+
+	return abilityEstimate
+}
+
+func (q quizAttempt) nextAdaptiveQuestion(quiz course.StepQuiz) NextQuestion {
+	abilityEstimate := q.estimateAbility(quiz)
+	ability := abilityEstimate.GetAbility()
+
+	if abilityEstimate.IsSettled() {
+		return NextQuestion{Finished: true, AbilityScore: ability}
+	}
+
+	answeredQuestionIDs := map[string]bool{}
+	for _, providedAnswer := range q.providedAnswers {
+		answeredQuestionIDs[providedAnswer.QuestionID] = true
+	}
+
+	nextQuestion := course.QuizQuestion{}
+	found := false
+	closestDistance := math.Inf(1)
+	for _, question := range q.orderQuiz(quiz).Questions {
+		if answeredQuestionIDs[question.ID] {
+			continue
+		}
+
+		distance := math.Abs(calculator.GetDifficultyLevel(question.Difficulty) - ability)
+		if distance < closestDistance {
+			closestDistance = distance
+			nextQuestion = question
+			found = true
+		}
+	}
+
+	if !found {
+		return NextQuestion{Finished: true, AbilityScore: ability}
+	}
+
+	return NextQuestion{Question: nextQuestion, AbilityScore: ability}
+}
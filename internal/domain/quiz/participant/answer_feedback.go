@@ -0,0 +1,95 @@
+package participant
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+)
+
+// AnswerFeedback tells the participant right after selecting an answer if it was correct.
+type AnswerFeedback struct {
+	QuestionID string
+	AnswerID   string
+	IsCorrect  bool
+
+	// Explanation is the description of the selected answer
+	Explanation string
+
+	CorrectAnswerIDs []string
+
+	// Confidence is empty for answers without confidence, those get no certainty mark
+	Confidence    string
+	CertaintyMark int
+}
+
+// AttemptProgress counts the answered questions of an attempt, TotalQuestions is 0 for adaptive attempts whose
+// length depends on the answers.
+type AttemptProgress struct {
+	AttemptID         int
+	AnsweredQuestions int
+	TotalQuestions    int
+	Completed         bool
+	Practice          bool
+}
+
+// GetAnswerFeedback returns the feedback to the latest answer to the question in the latest attempt of the quiz.
+func (p *Participant) GetAnswerFeedback(quiz course.StepQuiz, questionID string) (AnswerFeedback, error) {
+	quizAttempts := p.quizAttempts[quiz.ID]
+	if len(quizAttempts) == 0 {
+		return AnswerFeedback{}, fmt.Errorf("quiz %v not found", quiz.ID)
+	}
+
+	providedAnswers := p.getLatestQuizAttempt(quizAttempts).providedAnswers
+	for i := len(providedAnswers) - 1; i >= 0; i-- {
+		providedAnswer := providedAnswers[i]
+		if providedAnswer.QuestionID != questionID {
+			continue
+		}
+
+		answerFeedback := AnswerFeedback{
+			QuestionID:    providedAnswer.QuestionID,
+			AnswerID:      providedAnswer.AnswerID,
+			IsCorrect:     providedAnswer.IsCorrect,
+			Confidence:    providedAnswer.Confidence,
+			CertaintyMark: calculator.GetCertaintyMark(providedAnswer.Confidence, providedAnswer.IsCorrect),
+		}
+
+		question, _ := quiz.FindQuestion(questionID)
+		for _, answer := range question.Answers {
+			if answer.ID == providedAnswer.AnswerID {
+				answerFeedback.Explanation = answer.Description
+			}
+
+			if answer.IsCorrect {
+				answerFeedback.CorrectAnswerIDs = append(answerFeedback.CorrectAnswerIDs, answer.ID)
+			}
+		}
+
+		return answerFeedback, nil
+	}
+
+	return AnswerFeedback{}, fmt.Errorf("question %v of quiz %v is not answered in the latest attempt", questionID, quiz.ID)
+}
+
+// GetAttemptProgress returns the progress of the latest attempt of the quiz.
+func (p *Participant) GetAttemptProgress(quizID string) (AttemptProgress, error) {
+	quizAttempts := p.quizAttempts[quizID]
+	if len(quizAttempts) == 0 {
+		return AttemptProgress{}, fmt.Errorf("quiz %v not found", quizID)
+	}
+
+	latestQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+
+	answeredQuestionIDs := map[string]bool{}
+	for _, providedAnswer := range latestQuizAttempt.providedAnswers {
+		answeredQuestionIDs[providedAnswer.QuestionID] = true
+	}
+
+	return AttemptProgress{
+		AttemptID:         len(quizAttempts),
+		AnsweredQuestions: len(answeredQuestionIDs),
+		TotalQuestions:    len(latestQuizAttempt.requiredQuestionsAnswered),
+		Completed:         latestQuizAttempt.completed,
+		Practice:          latestQuizAttempt.practice,
+	}, nil
+}
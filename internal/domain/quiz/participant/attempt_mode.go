@@ -0,0 +1,27 @@
+package participant
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownAttemptMode = errors.New("unknown attempt mode")
+
+// AttemptMode decides if an attempt counts, exam attempts are graded while practice attempts give instant feedback and
+// count neither towards pass or fail, the attempt limit, the cooldown nor the leaderboards.
+type AttemptMode string
+
+const AttemptModeExam AttemptMode = "exam"
+const AttemptModePractice AttemptMode = "practice"
+
+// ParseAttemptMode returns the mode with the name, an empty name is an exam attempt.
+func ParseAttemptMode(mode string) (AttemptMode, error) {
+	switch AttemptMode(mode) {
+	case "", AttemptModeExam:
+		return AttemptModeExam, nil
+	case AttemptModePractice:
+		return AttemptModePractice, nil
+	}
+
+	return "", fmt.Errorf("attempt mode %q: %w", mode, ErrUnknownAttemptMode)
+}
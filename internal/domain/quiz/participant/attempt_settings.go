@@ -0,0 +1,76 @@
+package participant
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"time"
+)
+
+// AttemptSettings contains the quiz rules that apply when a new attempt is started.
+type AttemptSettings struct {
+	// TimeLimitMins is the duration of an attempt, 0 means no limit.
+	TimeLimitMins int
+
+	// MaxAttempts is the number of attempts a participant can start, 0 means unlimited attempts.
+	MaxAttempts int
+
+	// CooldownMins is the time a participant has to wait after a failed attempt, 0 means no cooldown.
+	CooldownMins int
+
+	// Quiz is the definition the questions of an attempt are drawn and shuffled from.
+	Quiz course.StepQuiz
+
+	Adaptive bool
+
+	// Mode is the mode the participant chose for the attempt, the zero value is an exam attempt.
+	Mode AttemptMode
+
+	// RetakeAttemptID is the finished attempt whose wrong answered questions are retaken, 0 attempts the whole quiz.
+	RetakeAttemptID int
+
+	// Course contains the quiz, the prerequisites of the quiz step have to be met before an attempt can start.
+	Course course.Course
+}
+
+func NewAttemptSettings(quiz course.StepQuiz) AttemptSettings {
+	return AttemptSettings{
+		TimeLimitMins: quiz.DurationMins,
+		MaxAttempts:   quiz.MaxAttempts,
+		CooldownMins:  quiz.CooldownMins,
+		Quiz:          quiz,
+		Adaptive:      quiz.Adaptive,
+	}
+}
+
+// NewCourseAttemptSettings creates the settings of a quiz that is part of the course.
+func NewCourseAttemptSettings(c course.Course, quiz course.StepQuiz) AttemptSettings {
+	settings := NewAttemptSettings(quiz)
+	settings.Course = c
+
+	return settings
+}
+
+func (s AttemptSettings) hasAttemptLimit() bool {
+	return s.MaxAttempts > 0
+}
+
+func (s AttemptSettings) isPractice() bool {
+	return s.Mode == AttemptModePractice
+}
+
+func (s AttemptSettings) isRetake() bool {
+	return s.RetakeAttemptID > 0
+}
+
+// locksAnswers returns true if the attempt reveals the correct answers while it counts, only practice attempts can
+// reveal them and still accept changed answers.
+func (s AttemptSettings) locksAnswers() bool {
+	return !s.isPractice() && !s.Quiz.FeedbackDisabled
+}
+
+func (s AttemptSettings) isGraded() bool {
+	return !s.isPractice() && !s.isRetake()
+}
+
+func (s AttemptSettings) cooldown() time.Duration {
+	return time.Duration(s.CooldownMins) * time.Minute
+}
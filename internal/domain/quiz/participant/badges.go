@@ -0,0 +1,92 @@
+package participant
+
+import (
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"sort"
+)
+
+// AwardBadges awards every badge of the course whose rule is met by the finished attempts of the course quizzes.
+// Already awarded badges are skipped, so the rules can be evaluated again at any time. Returns the awarded badge ids.
+func (p *Participant) AwardBadges(c course.Course, rules []badge.Rule) ([]string, error) {
+	finishedAttempts := p.GetFinishedAttempts(c)
+
+	var awardedBadgeIDs []string
+	for _, rule := range rules {
+		if p.hasBadge(c.ID, rule.BadgeID) || !rule.IsMetBy(finishedAttempts) {
+			continue
+		}
+
+		badgeAwardedEvent := event.BadgeAwarded{
+			CourseID:  c.ID,
+			BadgeID:   rule.BadgeID,
+			EventBase: p.createEventBaseEvent(),
+		}
+
+		err := p.apply(badgeAwardedEvent, false)
+		if err != nil {
+			return nil, err
+		}
+
+		awardedBadgeIDs = append(awardedBadgeIDs, rule.BadgeID)
+	}
+
+	return awardedBadgeIDs, nil
+}
+
+// GetAwardedBadges returns the badges of all courses ordered by the time they were awarded.
+func (p *Participant) GetAwardedBadges() []badge.AwardedBadge {
+	awardedBadges := make([]badge.AwardedBadge, len(p.badges))
+	copy(awardedBadges, p.badges)
+
+	sort.SliceStable(awardedBadges, func(i, j int) bool {
+		return awardedBadges[i].AwardedAt.Before(awardedBadges[j].AwardedAt)
+	})
+
+	return awardedBadges
+}
+
+func (p *Participant) hasBadge(courseID string, badgeID string) bool {
+	for _, awardedBadge := range p.badges {
+		if awardedBadge.CourseID == courseID && awardedBadge.BadgeID == badgeID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetFinishedAttempts returns the finished graded attempts of the quizzes of the course in no particular order.
+func (p *Participant) GetFinishedAttempts(c course.Course) []badge.FinishedAttempt {
+	var finishedAttempts []badge.FinishedAttempt
+
+	for quizID, quizAttempts := range p.quizAttempts {
+		if _, ok := c.FindQuiz(quizID); !ok {
+			continue
+		}
+
+		// practice attempts and retakes do not count as attempts at the quiz
+		gradedAttemptCount := 0
+		for _, quizAttempt := range quizAttempts {
+			if !quizAttempt.isGraded() {
+				continue
+			}
+
+			gradedAttemptCount++
+			if !quizAttempt.completed {
+				continue
+			}
+
+			finishedAttempts = append(finishedAttempts, badge.FinishedAttempt{
+				QuizID:        quizID,
+				AttemptNumber: gradedAttemptCount,
+				FinishedAt:    quizAttempt.finishedAt,
+				Pass:          quizAttempt.isPass(),
+				Perfect:       quizAttempt.isPerfect(),
+			})
+		}
+	}
+
+	return finishedAttempts
+}
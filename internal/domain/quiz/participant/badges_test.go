@@ -0,0 +1,68 @@
+package participant_test
+
+import (
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"testing"
+)
+
+var firstQuizPassedRule = badge.Rule{BadgeID: "first-quiz-passed", Condition: badge.ConditionQuizPassed}
+
+func TestParticipant_AwardBadges_AwardsBadgeOnce(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1")
+
+	awardedBadgeIDs := err.PanicIfError1(p.AwardBadges(certificateCourse, []badge.Rule{firstQuizPassedRule}))
+	if len(awardedBadgeIDs) != 1 || awardedBadgeIDs[0] != firstQuizPassedRule.BadgeID {
+		t.Fatalf("expected the first quiz passed badge, got %v", awardedBadgeIDs)
+	}
+
+	awardedBadgeIDs = err.PanicIfError1(p.AwardBadges(certificateCourse, []badge.Rule{firstQuizPassedRule}))
+	if len(awardedBadgeIDs) != 0 {
+		t.Fatalf("expected the badge to be awarded only once, got %v", awardedBadgeIDs)
+	}
+
+	if len(p.GetAwardedBadges()) != 1 {
+		t.Fatalf("expected one awarded badge, got %v", p.GetAwardedBadges())
+	}
+}
+
+func TestParticipant_AwardBadges_IgnoresQuizzesOfOtherCourses(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("other-course-quiz")
+
+	awardedBadgeIDs := err.PanicIfError1(p.AwardBadges(certificateCourse, []badge.Rule{firstQuizPassedRule}))
+	if len(awardedBadgeIDs) != 0 {
+		t.Fatalf("expected no badge for a quiz of another course, got %v", awardedBadgeIDs)
+	}
+}
+
+func TestParticipant_AwardBadges_IsIdempotentWhenEventsAreReplayed(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1")
+	err.PanicIfError1(p.AwardBadges(certificateCourse, []badge.Rule{firstQuizPassedRule}))
+
+	replayedParticipant := err.PanicIfError1(participant.NewFromEvents(p.GetEvents(), true))
+
+	awardedBadgeIDs := err.PanicIfError1(replayedParticipant.AwardBadges(certificateCourse, []badge.Rule{firstQuizPassedRule}))
+	if len(awardedBadgeIDs) != 0 {
+		t.Fatalf("expected no new badge after replaying the events, got %v", awardedBadgeIDs)
+	}
+
+	if len(replayedParticipant.GetAwardedBadges()) != 1 {
+		t.Fatalf("expected the replayed participant to keep its badge, got %v", replayedParticipant.GetAwardedBadges())
+	}
+}
+
+func TestParticipant_AwardBadges_PracticeAttemptDoesNotCountAsFirstAttempt(t *testing.T) {
+	passedOnFirstAttemptRule := badge.Rule{BadgeID: "first-attempt", Condition: badge.ConditionPassedOnFirstAttempt}
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings("quiz-1", []string{"question"}, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz-1", "question", "answer", false))
+	err.PanicIfError(p.FinishQuiz("quiz-1"))
+	passQuiz(&p, "quiz-1")
+
+	awardedBadgeIDs := err.PanicIfError1(p.AwardBadges(certificateCourse, []badge.Rule{passedOnFirstAttemptRule}))
+	if len(awardedBadgeIDs) != 1 {
+		t.Fatalf("expected the first graded attempt to earn the badge after a practice attempt, got %v", awardedBadgeIDs)
+	}
+}
@@ -0,0 +1,67 @@
+package calculator
+
+import "math"
+
+const abilityInitialStep = 1.0
+
+// abilitySettledStep is the step size at which the estimate no longer changes noticeably.
+const abilitySettledStep = 0.25
+
+// abilityMaxAnswers ends an estimate that keeps oscillating.
+const abilityMaxAnswers = 12
+
+const abilityMin = -2.0
+const abilityMax = 2.0
+
+var difficultyLevels = map[string]float64{
+	"easy":   -1,
+	"medium": 0,
+	"hard":   1,
+}
+
+// AbilityEstimate estimates the ability of a participant on the difficulty scale from -2 to 2. A correct answer
+// moves the estimate above the difficulty of the question, a wrong answer below. The step size halves every time
+// the direction changes, so the estimate settles around the ability of the participant.
+type AbilityEstimate struct {
+	ability       float64
+	step          float64
+	answerCount   int
+	lastIsCorrect bool
+}
+
+func NewAbilityEstimate() *AbilityEstimate {
+	return &AbilityEstimate{
+		ability: 0,
+		step:    abilityInitialStep,
+	}
+}
+
+func (ae *AbilityEstimate) AddAnswer(difficulty string, isCorrect bool) {
+	if ae.answerCount > 0 && ae.lastIsCorrect != isCorrect {
+		ae.step = ae.step / 2
+	}
+
+	level := GetDifficultyLevel(difficulty)
+	if isCorrect {
+		ae.ability = math.Max(ae.ability, level) + ae.step
+	} else {
+		ae.ability = math.Min(ae.ability, level) - ae.step
+	}
+	ae.ability = math.Min(math.Max(ae.ability, abilityMin), abilityMax)
+
+	ae.answerCount++
+	ae.lastIsCorrect = isCorrect
+}
+
+func (ae *AbilityEstimate) GetAbility() float64 {
+	return ae.ability
+}
+
+func (ae *AbilityEstimate) IsSettled() bool {
+	return ae.step <= abilitySettledStep || ae.answerCount >= abilityMaxAnswers
+}
+
+// GetDifficultyLevel maps a question difficulty to the ability scale, unknown difficulties count as medium.
+func GetDifficultyLevel(difficulty string) float64 {
+	return difficultyLevels[difficulty]
+}
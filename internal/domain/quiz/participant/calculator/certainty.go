@@ -0,0 +1,85 @@
+package calculator
+
+const ConfidenceLow = "low"
+const ConfidenceMedium = "medium"
+const ConfidenceHigh = "high"
+
+// ConfidenceLevels are the confidence levels of an answer from low to high.
+var ConfidenceLevels = []string{ConfidenceLow, ConfidenceMedium, ConfidenceHigh}
+
+// certaintyMarks follow certainty-based marking, confident correct answers earn more and confident wrong answers cost
+// more than a guess.
+var certaintyMarks = map[string]struct{ correct, wrong int }{
+	ConfidenceLow:    {correct: 1, wrong: 0},
+	ConfidenceMedium: {correct: 2, wrong: -2},
+	ConfidenceHigh:   {correct: 3, wrong: -6},
+}
+
+func IsConfidence(confidence string) bool {
+	_, ok := certaintyMarks[confidence]
+	return ok
+}
+
+// GetCertaintyMark returns the mark of an answer with the confidence, answers without confidence get no mark.
+func GetCertaintyMark(confidence string, isCorrect bool) int {
+	marks, ok := certaintyMarks[confidence]
+	if !ok {
+		return 0
+	}
+
+	if isCorrect {
+		return marks.correct
+	}
+
+	return marks.wrong
+}
+
+// CalibrationLevel compares the accuracy of the answers given with the confidence.
+type CalibrationLevel struct {
+	Confidence     string
+	Answers        int
+	CorrectAnswers int
+}
+
+// GetAccuracy returns the ratio of correct answers, 0 without answers.
+func (l CalibrationLevel) GetAccuracy() float64 {
+	if l.Answers == 0 {
+		return 0
+	}
+
+	return float64(l.CorrectAnswers) / float64(l.Answers)
+}
+
+// Calibration tells if the confidence of a participant matches the accuracy, a calibrated participant is more often
+// correct with higher confidence.
+type Calibration struct {
+	// Levels are in the order of ConfidenceLevels
+	Levels []CalibrationLevel
+
+	// CertaintyScore sums the certainty marks of the answers
+	CertaintyScore int
+}
+
+func NewCalibration() Calibration {
+	levels := make([]CalibrationLevel, 0, len(ConfidenceLevels))
+	for _, confidence := range ConfidenceLevels {
+		levels = append(levels, CalibrationLevel{Confidence: confidence})
+	}
+
+	return Calibration{Levels: levels}
+}
+
+// AddAnswer counts the answer at its confidence level, answers without confidence are ignored.
+func (c *Calibration) AddAnswer(confidence string, isCorrect bool) {
+	for i := range c.Levels {
+		if c.Levels[i].Confidence != confidence {
+			continue
+		}
+
+		c.Levels[i].Answers++
+		if isCorrect {
+			c.Levels[i].CorrectAnswers++
+		}
+		c.CertaintyScore += GetCertaintyMark(confidence, isCorrect)
+	}
+}
@@ -0,0 +1,28 @@
+package calculator
+
+// experiencePointsPerCorrectAnswer rewards harder questions with more points, questions without difficulty count as medium.
+var experiencePointsPerCorrectAnswer = map[string]int{
+	"easy":   10,
+	"medium": 15,
+	"hard":   20,
+}
+
+// ExperiencePointsPerWrongAnswer rewards the effort of answering even if the answer is wrong.
+const ExperiencePointsPerWrongAnswer = 2
+
+// ExperiencePointsPerCorrectReview is less than a correct quiz answer, the question is already known.
+const ExperiencePointsPerCorrectReview = 5
+
+// GetExperiencePoints returns the experience points of the final answer to a question of a finished quiz attempt.
+func GetExperiencePoints(difficulty string, isCorrect bool) int {
+	if !isCorrect {
+		return ExperiencePointsPerWrongAnswer
+	}
+
+	experiencePoints, ok := experiencePointsPerCorrectAnswer[difficulty]
+	if !ok {
+		return experiencePointsPerCorrectAnswer["medium"]
+	}
+
+	return experiencePoints
+}
@@ -0,0 +1,68 @@
+package calculator
+
+import "time"
+
+// MaxQuestionIdleSecs caps the time between two activities of an attempt, a longer gap means the participant left the
+// quiz and only counts up to the cap.
+const MaxQuestionIdleSecs = 300
+
+// QuestionTimer measures the time spent on every question of an attempt. The time between two activities is spent on
+// the question viewed last. Attempts without viewed questions spend the time before an answer on the answered
+// question instead.
+type QuestionTimer struct {
+	viewedQuestionID string
+	lastActivityAt   time.Time
+	timeSpentSecs    map[string]int
+}
+
+func NewQuestionTimer(startedAt time.Time) *QuestionTimer {
+	return &QuestionTimer{
+		lastActivityAt: startedAt,
+		timeSpentSecs:  map[string]int{},
+	}
+}
+
+// View spends the time since the last activity on the question viewed before and switches to the viewed question.
+func (qt *QuestionTimer) View(questionID string, viewedAt time.Time) {
+	qt.spend(qt.viewedQuestionID, viewedAt)
+	qt.viewedQuestionID = questionID
+}
+
+// Activity spends the time since the last activity, e.g. before an answer or a hint of the question.
+func (qt *QuestionTimer) Activity(questionID string, at time.Time) {
+	spendingQuestionID := qt.viewedQuestionID
+	if spendingQuestionID == "" {
+		spendingQuestionID = questionID
+	}
+
+	qt.spend(spendingQuestionID, at)
+}
+
+// Stop spends the time until the end of the attempt on the question viewed last.
+func (qt *QuestionTimer) Stop(at time.Time) {
+	qt.spend(qt.viewedQuestionID, at)
+	qt.viewedQuestionID = ""
+}
+
+func (qt *QuestionTimer) spend(questionID string, at time.Time) {
+	secs := min(max(int(at.Sub(qt.lastActivityAt).Seconds()), 0), MaxQuestionIdleSecs)
+	if at.After(qt.lastActivityAt) {
+		qt.lastActivityAt = at
+	}
+
+	if questionID == "" {
+		return
+	}
+
+	qt.timeSpentSecs[questionID] += secs
+}
+
+// GetTimeSpentSecs returns the seconds spent per question, questions without time are not contained.
+func (qt *QuestionTimer) GetTimeSpentSecs() map[string]int {
+	timeSpentSecs := map[string]int{}
+	for questionID, secs := range qt.timeSpentSecs {
+		timeSpentSecs[questionID] = secs
+	}
+
+	return timeSpentSecs
+}
@@ -4,6 +4,9 @@ import "math"
 
 type QuizResult struct {
 	answerResults map[string]bool
+
+	// hintPenalties sums the penalties of the used hints per question
+	hintPenalties map[string]float64
 }
 
 const QuizPassThresold = 0.8
@@ -11,6 +14,7 @@ const QuizPassThresold = 0.8
 func NewQuizResultCalculator() *QuizResult {
 	return &QuizResult{
 		answerResults: map[string]bool{},
+		hintPenalties: map[string]float64{},
 	}
 }
 
@@ -18,21 +22,36 @@ func (qr *QuizResult) AddAnswer(questionID string, isCorrect bool) {
 	qr.answerResults[questionID] = isCorrect
 }
 
+// AddUnansweredQuestions counts every given question without an answer as incorrectly answered.
+func (qr *QuizResult) AddUnansweredQuestions(questionIDs []string) {
+	for _, questionID := range questionIDs {
+		if _, ok := qr.answerResults[questionID]; !ok {
+			qr.answerResults[questionID] = false
+		}
+	}
+}
+
+// AddHintPenalty deducts the penalty of a used hint from the score of a correct answer to the question.
+func (qr *QuizResult) AddHintPenalty(questionID string, penalty float64) {
+	qr.hintPenalties[questionID] += penalty
+}
+
+// GetCorrectRatio returns the score of the answers, a correct answer scores 1 minus the penalties of its used hints.
 func (qr *QuizResult) GetCorrectRatio() float64 {
 	if len(qr.answerResults) == 0 {
 		return 0
 	}
 
-	correctAnswers := 0
+	score := 0.0
 	totalAnswers := len(qr.answerResults)
 
-	for _, isCorrect := range qr.answerResults {
+	for questionID, isCorrect := range qr.answerResults {
 		if isCorrect {
-			correctAnswers++
+			score += math.Max(1-qr.hintPenalties[questionID], 0)
 		}
 	}
 
-	return float64(correctAnswers) / float64(totalAnswers)
+	return score / float64(totalAnswers)
 }
 
 func (qr *QuizResult) IsPass() bool {
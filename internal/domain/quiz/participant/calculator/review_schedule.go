@@ -0,0 +1,58 @@
+package calculator
+
+import (
+	"math"
+	"time"
+)
+
+const ReviewQualityMin = 0
+const ReviewQualityMax = 5
+
+// ReviewQualityForgotten is used for questions answered wrong in a quiz attempt.
+const ReviewQualityForgotten = 1
+
+// ReviewQualityBarelyRemembered is used for questions answered correctly after a wrong answer in a quiz attempt.
+const ReviewQualityBarelyRemembered = 3
+
+const reviewInitialEaseFactor = 2.5
+const reviewMinEaseFactor = 1.3
+
+// ReviewSchedule schedules the next review of a question with the SM-2 algorithm. The quality of a review goes from
+// 0 (complete blackout) to 5 (perfect response), reviews below 3 start the repetitions from scratch.
+type ReviewSchedule struct {
+	Repetitions  int
+	IntervalDays int
+	EaseFactor   float64
+	DueAt        time.Time
+}
+
+func NewReviewSchedule() ReviewSchedule {
+	return ReviewSchedule{
+		EaseFactor: reviewInitialEaseFactor,
+	}
+}
+
+func (rs ReviewSchedule) Next(quality int, reviewedAt time.Time) ReviewSchedule {
+	next := rs
+
+	if quality < 3 {
+		next.Repetitions = 0
+		next.IntervalDays = 1
+	} else {
+		switch rs.Repetitions {
+		case 0:
+			next.IntervalDays = 1
+		case 1:
+			next.IntervalDays = 6
+		default:
+			next.IntervalDays = int(math.Round(float64(rs.IntervalDays) * rs.EaseFactor))
+		}
+		next.Repetitions++
+	}
+
+	qualityGap := float64(ReviewQualityMax - quality)
+	next.EaseFactor = math.Max(rs.EaseFactor+(0.1-qualityGap*(0.08+qualityGap*0.02)), reviewMinEaseFactor)
+	next.DueAt = reviewedAt.AddDate(0, 0, next.IntervalDays)
+
+	return next
+}
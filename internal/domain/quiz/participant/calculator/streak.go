@@ -0,0 +1,73 @@
+package calculator
+
+import "time"
+
+// StreakDaysPerFreezeDay is the number of active days that earn one freeze day.
+const StreakDaysPerFreezeDay = 7
+
+// StreakMaxFreezeDays limits the freeze days that can be saved up.
+const StreakMaxFreezeDays = 2
+
+// Streak counts consecutive calendar days with activity. A day without activity uses a saved freeze day and keeps the
+// streak alive without extending it, without freeze days left the streak is lost.
+type Streak struct {
+	CurrentDays         int
+	LongestDays         int
+	AvailableFreezeDays int
+}
+
+// NewStreak walks every calendar day from the first active day until today. Days are compared by their date only,
+// so they have to be given in the timezone of the participant. Today does not break the streak before it is over.
+func NewStreak(activeDays []time.Time, today time.Time) Streak {
+	activeDates := map[time.Time]bool{}
+	firstDate := time.Time{}
+
+	for _, activeDay := range activeDays {
+		date := toDate(activeDay)
+		activeDates[date] = true
+
+		if firstDate.IsZero() || date.Before(firstDate) {
+			firstDate = date
+		}
+	}
+
+	streak := Streak{}
+	if firstDate.IsZero() {
+		return streak
+	}
+
+	todayDate := toDate(today)
+	activeDaysSinceFreezeDay := 0
+
+	for date := firstDate; !date.After(todayDate); date = date.AddDate(0, 0, 1) {
+		switch {
+		case activeDates[date]:
+			streak.CurrentDays++
+			activeDaysSinceFreezeDay++
+
+			if activeDaysSinceFreezeDay == StreakDaysPerFreezeDay {
+				activeDaysSinceFreezeDay = 0
+				streak.AvailableFreezeDays = min(streak.AvailableFreezeDays+1, StreakMaxFreezeDays)
+			}
+
+		case date.Equal(todayDate):
+			// today is not over yet, activity can still follow
+
+		case streak.AvailableFreezeDays > 0:
+			streak.AvailableFreezeDays--
+
+		default:
+			streak.CurrentDays = 0
+			activeDaysSinceFreezeDay = 0
+		}
+
+		streak.LongestDays = max(streak.LongestDays, streak.CurrentDays)
+	}
+
+	return streak
+}
+
+// toDate drops the time and timezone but keeps the calendar day of the given time.
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,120 @@
+package participant
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrCertificateNotFound = errors.New("certificate not found")
+
+var ErrInvalidCertificateSignature = errors.New("certificate signature is invalid")
+
+// Certificate confirms that a participant completed a course.
+type Certificate struct {
+	ID            string
+	ParticipantID string
+	CourseID      string
+	CompletedAt   time.Time
+	Signature     string
+}
+
+// CertificateSigner signs certificates, so a certificate can only be issued by this system.
+type CertificateSigner interface {
+	Sign(certificate Certificate) string
+}
+
+// NewCertificateID creates a unique certificate id that references the participant, so a certificate can be
+// verified without knowing the participant.
+func NewCertificateID(participantID string) string {
+	return fmt.Sprintf("%s.%s", base64.RawURLEncoding.EncodeToString([]byte(participantID)), uuid.MustNewRandomAsString())
+}
+
+// ParseCertificateID returns the id of the participant the certificate was issued to.
+func ParseCertificateID(certificateID string) (string, error) {
+	encodedParticipantID, _, found := strings.Cut(certificateID, ".")
+	if !found {
+		return "", fmt.Errorf("certificate id %v is malformed: %w", certificateID, ErrCertificateNotFound)
+	}
+
+	participantID, err := base64.RawURLEncoding.DecodeString(encodedParticipantID)
+	if err != nil {
+		return "", fmt.Errorf("certificate id %v is malformed: %w", certificateID, ErrCertificateNotFound)
+	}
+
+	return string(participantID), nil
+}
+
+// IsValid returns true if the signature of the certificate matches the signature issued by the signer.
+func (c Certificate) IsValid(signer CertificateSigner) bool {
+	return c.Signature != "" && c.Signature == signer.Sign(c)
+}
+
+// CompleteCourse issues the certificate of the course once every step is passed. Returns false if the course is
+// not passed yet or was already completed.
+func (p *Participant) CompleteCourse(c course.Course, signer CertificateSigner) (bool, error) {
+	if _, ok := p.certificates[c.ID]; ok {
+		return false, nil
+	}
+
+	if !c.IsPassed(p.GetPassedQuizIDs()) {
+		return false, nil
+	}
+
+	courseCompletedEvent := event.CourseCompleted{
+		CourseID:      c.ID,
+		CertificateID: NewCertificateID(p.id),
+		EventBase:     p.createEventBaseEvent(),
+	}
+
+	courseCompletedEvent.Signature = signer.Sign(Certificate{
+		ID:            courseCompletedEvent.CertificateID,
+		ParticipantID: p.id,
+		CourseID:      c.ID,
+		CompletedAt:   courseCompletedEvent.CreatedAt,
+	})
+
+	err := p.apply(courseCompletedEvent, false)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCourseCertificate returns the certificate of the course, false if the course is not completed yet.
+func (p *Participant) GetCourseCertificate(courseID string) (Certificate, bool) {
+	certificate, ok := p.certificates[courseID]
+
+	return certificate, ok
+}
+
+func (p *Participant) GetCertificate(certificateID string) (Certificate, error) {
+	for _, certificate := range p.certificates {
+		if certificate.ID == certificateID {
+			return certificate, nil
+		}
+	}
+
+	return Certificate{}, fmt.Errorf("certificate %v: %w", certificateID, ErrCertificateNotFound)
+}
+
+// GetCertificates returns the certificates of all completed courses ordered by completion.
+func (p *Participant) GetCertificates() []Certificate {
+	certificates := make([]Certificate, 0, len(p.certificates))
+	for _, certificate := range p.certificates {
+		certificates = append(certificates, certificate)
+	}
+
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].CompletedAt.Before(certificates[j].CompletedAt)
+	})
+
+	return certificates
+}
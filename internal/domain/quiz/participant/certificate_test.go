@@ -0,0 +1,119 @@
+package participant_test
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"testing"
+	"time"
+)
+
+var certificateCourse = course.Course{
+	ID: "course",
+	Steps: []course.Step{
+		{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz-1"}}},
+		{ID: "step-2", Quizzes: []course.StepQuiz{{ID: "quiz-2"}}},
+	},
+}
+
+type courseIDSigner struct {
+}
+
+func (s courseIDSigner) Sign(certificate participant.Certificate) string {
+	return certificate.ID + certificate.CourseID
+}
+
+func TestParticipant_CompleteCourse_IssuesCertificateOnceAllStepsArePassed(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1")
+
+	completed := err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+	if completed {
+		t.Fatalf("expected the course not to be completed with a step left")
+	}
+
+	passQuiz(&p, "quiz-2")
+
+	completed = err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+	if !completed {
+		t.Fatalf("expected the course to be completed")
+	}
+
+	certificate, ok := p.GetCourseCertificate(certificateCourse.ID)
+	if !ok || !certificate.IsValid(courseIDSigner{}) || certificate.ParticipantID != p.GetID() {
+		t.Fatalf("expected a valid certificate of the participant, got %v", certificate)
+	}
+}
+
+func TestParticipant_CompleteCourse_IsIssuedOnlyOnce(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1", "quiz-2")
+	err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+
+	completed := err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+	if completed {
+		t.Fatalf("expected the course to be completed only once")
+	}
+
+	if len(p.GetCertificates()) != 1 {
+		t.Fatalf("expected exactly one certificate, got %v", p.GetCertificates())
+	}
+}
+
+func TestParticipant_GetCertificate_FindsCertificateByID(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1", "quiz-2")
+	err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+	issuedCertificate, _ := p.GetCourseCertificate(certificateCourse.ID)
+
+	certificate := err.PanicIfError1(p.GetCertificate(issuedCertificate.ID))
+	if certificate.CourseID != certificateCourse.ID {
+		t.Fatalf("expected certificate of the course, got %v", certificate)
+	}
+
+	_, getErr := p.GetCertificate("unknown")
+	if !errors.Is(getErr, participant.ErrCertificateNotFound) {
+		t.Fatalf("expected certificate not found error, got %v", getErr)
+	}
+}
+
+func TestParticipant_ParseCertificateID_ReturnsParticipantID(t *testing.T) {
+	participantID := newUUID()
+
+	parsedParticipantID := err.PanicIfError1(participant.ParseCertificateID(participant.NewCertificateID(participantID)))
+	if parsedParticipantID != participantID {
+		t.Fatalf("expected participant id %v, got %v", participantID, parsedParticipantID)
+	}
+
+	_, parseErr := participant.ParseCertificateID("malformed")
+	if !errors.Is(parseErr, participant.ErrCertificateNotFound) {
+		t.Fatalf("expected certificate not found error, got %v", parseErr)
+	}
+}
+
+func TestCertificate_IsValid_RejectsTamperedCertificate(t *testing.T) {
+	p := createParticipantWithPassedQuizzes("quiz-1", "quiz-2")
+	err.PanicIfError1(p.CompleteCourse(certificateCourse, courseIDSigner{}))
+	certificate, _ := p.GetCourseCertificate(certificateCourse.ID)
+
+	certificate.CourseID = "other-course"
+	certificate.CompletedAt = time.Now()
+
+	if certificate.IsValid(courseIDSigner{}) {
+		t.Fatalf("expected a tampered certificate to be invalid")
+	}
+}
+
+func createParticipantWithPassedQuizzes(quizIDs ...string) participant.Participant {
+	p := err.PanicIfError1(participant.New())
+
+	for _, quizID := range quizIDs {
+		passQuiz(&p, quizID)
+	}
+
+	return p
+}
+
+func passQuiz(p *participant.Participant, quizID string) {
+	err.PanicIfError(p.StartQuiz(quizID, []string{"question"}))
+	err.PanicIfError(p.SelectQuizAnswer(quizID, "question", "answer", true))
+	err.PanicIfError(p.FinishQuiz(quizID))
+}
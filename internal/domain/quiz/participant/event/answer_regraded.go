@@ -0,0 +1,19 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// AnswerRegraded replaces the correctness of every answer with the answer id to the question in the attempt, it is
+// emitted when the answer key of the question was corrected after the answer was selected.
+type AnswerRegraded struct {
+	QuizID     string
+	AttemptID  int
+	QuestionID string
+	AnswerID   string
+	IsCorrect  bool
+	eventsource.EventBase
+}
+
+var AnswerRegradedTypeName = reflect.TypeOf(AnswerRegraded{}).Name()
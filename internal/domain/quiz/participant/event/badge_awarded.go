@@ -0,0 +1,15 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// BadgeAwarded is emitted once the badge rule of a course is met, every badge is awarded only once.
+type BadgeAwarded struct {
+	CourseID string
+	BadgeID  string
+	eventsource.EventBase
+}
+
+var BadgeAwardedTypeName = reflect.TypeOf(BadgeAwarded{}).Name()
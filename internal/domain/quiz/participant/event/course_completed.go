@@ -0,0 +1,18 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// CourseCompleted is emitted once every step of the course is passed and issues the certificate of the course.
+type CourseCompleted struct {
+	CourseID      string
+	CertificateID string
+
+	// Signature signs the certificate, see participant.CertificateSigner.
+	Signature string
+	eventsource.EventBase
+}
+
+var CourseCompletedTypeName = reflect.TypeOf(CourseCompleted{}).Name()
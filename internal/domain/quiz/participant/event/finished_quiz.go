@@ -9,6 +9,9 @@ type FinishedQuiz struct {
 	QuizID string
 	eventsource.EventBase
 	Pass bool
+
+	// AbilityScore is the final ability estimate of an adaptive attempt, from -2 (easy) to 2 (hard).
+	AbilityScore float64
 }
 
 var FinishedQuizTypeName = reflect.TypeOf(FinishedQuiz{}).Name()
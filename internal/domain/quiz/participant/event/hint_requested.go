@@ -0,0 +1,18 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// HintRequested reveals the hint with the index of the question in the ongoing attempt. The penalty is the hint penalty
+// of the quiz at the time of the request, so later changes of the quiz do not change the result of the attempt.
+type HintRequested struct {
+	QuizID     string
+	QuestionID string
+	HintIndex  int
+	Penalty    float64
+	eventsource.EventBase
+}
+
+var HintRequestedTypeName = reflect.TypeOf(HintRequested{}).Name()
@@ -0,0 +1,14 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// LeaderboardOptOutSet hides the participant from all leaderboards while OptOut is true.
+type LeaderboardOptOutSet struct {
+	OptOut bool
+	eventsource.EventBase
+}
+
+var LeaderboardOptOutSetTypeName = reflect.TypeOf(LeaderboardOptOutSet{}).Name()
@@ -0,0 +1,18 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuestionIssueReported is a problem with a question reported by the participant, e.g. a wrong answer or a typo.
+type QuestionIssueReported struct {
+	ReportID   string
+	QuizID     string
+	QuestionID string
+	Category   string
+	Text       string
+	eventsource.EventBase
+}
+
+var QuestionIssueReportedTypeName = reflect.TypeOf(QuestionIssueReported{}).Name()
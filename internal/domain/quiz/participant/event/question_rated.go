@@ -0,0 +1,16 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuestionRated replaces any previous rating of the question by the participant.
+type QuestionRated struct {
+	QuizID     string
+	QuestionID string
+	Stars      int
+	eventsource.EventBase
+}
+
+var QuestionRatedTypeName = reflect.TypeOf(QuestionRated{}).Name()
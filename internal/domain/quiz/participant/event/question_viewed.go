@@ -0,0 +1,16 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuestionViewed is emitted when the participant opens a question of the ongoing attempt, the time until the next
+// activity of the attempt is spent on the question.
+type QuestionViewed struct {
+	QuizID     string
+	QuestionID string
+	eventsource.EventBase
+}
+
+var QuestionViewedTypeName = reflect.TypeOf(QuestionViewed{}).Name()
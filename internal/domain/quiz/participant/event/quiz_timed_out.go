@@ -0,0 +1,18 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// QuizTimedOut closes an attempt whose time limit has been exceeded. The attempt is scored on the
+// answers given until the deadline.
+type QuizTimedOut struct {
+	QuizID string
+	eventsource.EventBase
+
+	// AbilityScore is the ability estimate of an adaptive attempt at the deadline, from -2 (easy) to 2 (hard).
+	AbilityScore float64
+}
+
+var QuizTimedOutTypeName = reflect.TypeOf(QuizTimedOut{}).Name()
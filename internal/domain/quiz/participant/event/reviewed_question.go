@@ -0,0 +1,20 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// ReviewedQuestion is an answer given in the review queue, it is not part of any quiz attempt.
+type ReviewedQuestion struct {
+	QuizID     string
+	QuestionID string
+	AnswerID   string
+	IsCorrect  bool
+
+	// Quality is the self-assessed recall from 0 (forgotten) to 5 (perfect).
+	Quality int
+	eventsource.EventBase
+}
+
+var ReviewedQuestionTypeName = reflect.TypeOf(ReviewedQuestion{}).Name()
@@ -10,6 +10,9 @@ type SelectedAnswer struct {
 	QuestionID string
 	AnswerID   string
 	IsCorrect  bool
+
+	// Confidence is low, medium or high, empty for answers without confidence
+	Confidence string
 	eventsource.EventBase
 }
 
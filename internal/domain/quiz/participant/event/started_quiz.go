@@ -2,13 +2,59 @@ package event
 
 import (
 	"learn-to-code/internal/domain/eventsource"
+	"math"
 	"reflect"
+	"time"
 )
 
 type StartedQuiz struct {
 	QuizID                    string
 	RequiredQuestionsAnswered []string
+	TimeLimitMins             int
+
+	// QuestionOrder and AnswerOrders are the question ids and the answer ids per question in the order of the attempt.
+	QuestionOrder []string
+	AnswerOrders  map[string][]string
+
+	// DrawnQuestionIDs are the questions drawn from a question pool for the attempt, nil if the quiz is no pool.
+	DrawnQuestionIDs []string
+
+	// Adaptive attempts have no required questions, the next question is chosen by the answers so far.
+	Adaptive bool
+
+	// Practice attempts do not count towards pass or fail, the attempt limit, the cooldown and the leaderboards.
+	Practice bool
+
+	// RetakeOfAttemptID is the attempt whose wrong answered questions are retaken, 0 for attempts of the whole quiz.
+	// Like practice attempts retakes never replace the result of the quiz.
+	RetakeOfAttemptID int
+
+	// AnswersLocked attempts reveal the correct answers right after every answer, so every question can only be
+	// answered once.
+	AnswersLocked bool
 	eventsource.EventBase
 }
 
 var StartedQuizTypeName = reflect.TypeOf(StartedQuiz{}).Name()
+
+// IsGraded returns true for exam attempts of the whole quiz, only they count towards the result of the quiz.
+func (e StartedQuiz) IsGraded() bool {
+	return !e.Practice && e.RetakeOfAttemptID == 0
+}
+
+func (e StartedQuiz) IsTimed() bool {
+	return e.TimeLimitMins > 0
+}
+
+func (e StartedQuiz) GetDeadline() time.Time {
+	return e.CreatedAt.Add(time.Duration(e.TimeLimitMins) * time.Minute)
+}
+
+// GetRemainingTimeSecs returns the seconds left until the deadline, 0 for attempts without a time limit.
+func (e StartedQuiz) GetRemainingTimeSecs(now time.Time) int {
+	if !e.IsTimed() {
+		return 0
+	}
+
+	return max(int(math.Floor(e.GetDeadline().Sub(now).Seconds())), 0)
+}
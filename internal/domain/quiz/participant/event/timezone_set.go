@@ -0,0 +1,14 @@
+package event
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"reflect"
+)
+
+// TimezoneSet stores the IANA timezone of the participant, calendar days like streaks are counted in it.
+type TimezoneSet struct {
+	Timezone string
+	eventsource.EventBase
+}
+
+var TimezoneSetTypeName = reflect.TypeOf(TimezoneSet{}).Name()
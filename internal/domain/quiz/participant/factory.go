@@ -3,8 +3,8 @@ package participant
 import (
 	"learn-to-code/internal/domain/eventsource"
 	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/clock"
 	"learn-to-code/internal/infrastructure/go/util/uuid"
-	"time"
 )
 
 func New() (Participant, error) {
@@ -12,20 +12,36 @@ func New() (Participant, error) {
 }
 
 func NewParticipant(id string) (Participant, error) {
+	return NewParticipantWithClock(id, clock.NewSystemClock())
+}
+
+// NewParticipantWithClock creates the participant and all of its events at the time of the clock.
+func NewParticipantWithClock(id string, c clock.Clock) (Participant, error) {
 	participantCreated := event.ParticipantCreated{
 		EventBase: eventsource.EventBase{
 			AggregateID: id,
 			Version:     0,
-			CreatedAt:   time.Now(),
+			CreatedAt:   c.Now(),
 		},
 	}
-	return NewFromEvents([]eventsource.Event{participantCreated}, false)
+
+	p, err := NewFromEvents([]eventsource.Event{participantCreated}, false)
+	if err != nil {
+		return Participant{}, err
+	}
+
+	p.UseClock(c)
+
+	return p, nil
 }
 
 func NewFromEvents(events []eventsource.Event, isPersisted bool) (Participant, error) {
 
 	p := Participant{
-		quizAttempts: map[string][]*quizAttempt{},
+		quizAttempts:    map[string][]*quizAttempt{},
+		certificates:    map[string]Certificate{},
+		questionRatings: map[string]QuestionRating{},
+		clock:           clock.NewSystemClock(),
 	}
 
 	for _, e := range events {
@@ -0,0 +1,122 @@
+package participant
+
+import (
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/event"
+)
+
+var ErrNoHintLeft = errors.New("question has no hint left")
+
+// Hint is a hint revealed to the participant, the penalty is deducted from the score of a correct answer.
+type Hint struct {
+	QuestionID     string
+	Index          int
+	Text           string
+	Penalty        float64
+	RemainingHints int
+}
+
+type usedHint struct {
+	questionID string
+	penalty    float64
+}
+
+// RequestHint reveals the next hint of the question in the ongoing attempt of the quiz.
+func (p *Participant) RequestHint(quiz course.StepQuiz, questionID string) (Hint, error) {
+	question, ok := quiz.FindQuestion(questionID)
+	if !ok {
+		return Hint{}, fmt.Errorf("question %v of quiz %v: %w", questionID, quiz.ID, course.ErrQuestionNotFound)
+	}
+
+	hintIndex := 0
+	if quizAttempts, ok := p.quizAttempts[quiz.ID]; ok {
+		hintIndex = p.getLatestQuizAttempt(quizAttempts).getUsedHintCount(questionID)
+	}
+
+	if hintIndex >= len(question.Hints) {
+		return Hint{}, fmt.Errorf("can not request hint of question %v: %w", questionID, ErrNoHintLeft)
+	}
+
+	hintRequestedEvent := event.HintRequested{
+		EventBase:  p.createEventBaseEvent(),
+		QuizID:     quiz.ID,
+		QuestionID: questionID,
+		HintIndex:  hintIndex,
+		Penalty:    quiz.HintPenalty,
+	}
+
+	err := p.apply(hintRequestedEvent, false)
+	if err != nil {
+		return Hint{}, err
+	}
+
+	return newHint(question, hintIndex, quiz.HintPenalty), nil
+}
+
+// GetUsedHints returns the hints of the question used in the latest attempt of the quiz in the order of the requests.
+func (p *Participant) GetUsedHints(quiz course.StepQuiz, questionID string) []Hint {
+	quizAttempts, ok := p.quizAttempts[quiz.ID]
+	if !ok {
+		return nil
+	}
+
+	question, _ := quiz.FindQuestion(questionID)
+
+	var hints []Hint
+	for _, usedHint := range p.getLatestQuizAttempt(quizAttempts).usedHints {
+		if usedHint.questionID == questionID {
+			hints = append(hints, newHint(question, len(hints), usedHint.penalty))
+		}
+	}
+
+	return hints
+}
+
+func (p *Participant) useHint(e event.HintRequested) error {
+	quizAttempts, ok := p.quizAttempts[e.QuizID]
+	if !ok {
+		return fmt.Errorf("lastQuizAttempt %v not found", e.QuizID)
+	}
+
+	lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+	if lastQuizAttempt.completed {
+		return fmt.Errorf("can not request a hint for quiz %v that is already completed", e.QuizID)
+	}
+
+	if lastQuizAttempt.isExpiredAt(e.CreatedAt) {
+		return fmt.Errorf("can not request a hint for quiz %v: %w", e.QuizID, ErrTimeLimitExceeded)
+	}
+
+	if !lastQuizAttempt.isQuestionDrawn(e.QuestionID) {
+		return fmt.Errorf("question %v was not drawn for the attempt of quiz %v", e.QuestionID, e.QuizID)
+	}
+
+	if e.HintIndex != lastQuizAttempt.getUsedHintCount(e.QuestionID) {
+		return fmt.Errorf("hints of question %v have to be requested in order, expected hint %d", e.QuestionID, lastQuizAttempt.getUsedHintCount(e.QuestionID))
+	}
+
+	lastQuizAttempt.usedHints = append(lastQuizAttempt.usedHints, usedHint{
+		questionID: e.QuestionID,
+		penalty:    e.Penalty,
+	})
+
+	return nil
+}
+
+func newHint(question course.QuizQuestion, hintIndex int, penalty float64) Hint {
+	hint := Hint{
+		QuestionID:     question.ID,
+		Index:          hintIndex,
+		Penalty:        penalty,
+		RemainingHints: max(len(question.Hints)-hintIndex-1, 0),
+	}
+
+	// hints removed from the quiz after they were used have no text anymore
+	if hintIndex < len(question.Hints) {
+		hint.Text = question.Hints[hintIndex]
+	}
+
+	return hint
+}
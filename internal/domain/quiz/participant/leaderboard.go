@@ -0,0 +1,19 @@
+package participant
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/event"
+)
+
+// SetLeaderboardOptOut hides the participant from all leaderboards or shows the participant again.
+func (p *Participant) SetLeaderboardOptOut(optOut bool) error {
+	leaderboardOptOutSetEvent := event.LeaderboardOptOutSet{
+		OptOut:    optOut,
+		EventBase: p.createEventBaseEvent(),
+	}
+
+	return p.apply(leaderboardOptOutSetEvent, false)
+}
+
+func (p *Participant) IsLeaderboardOptOut() bool {
+	return p.leaderboardOptOut
+}
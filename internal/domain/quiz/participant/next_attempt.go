@@ -0,0 +1,15 @@
+package participant
+
+import "time"
+
+// NextAttempt describes if and when a participant can start the next attempt of a quiz.
+type NextAttempt struct {
+	Allowed bool
+
+	// AllowedAt is the earliest time the next attempt can be started, it is zero if no attempts are left or an attempt
+	// is ongoing.
+	AllowedAt time.Time
+
+	// RemainingAttempts is the number of attempts left, it is -1 if the quiz has no attempt limit.
+	RemainingAttempts int
+}
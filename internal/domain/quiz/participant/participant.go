@@ -1,21 +1,68 @@
 package participant
 
 import (
+	"errors"
 	"fmt"
 	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
 	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/clock"
+	"math"
+	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"time"
 )
 
+var ErrTimeLimitExceeded = errors.New("time limit of the quiz attempt exceeded")
+
+var ErrMaxAttemptsReached = errors.New("maximum number of quiz attempts reached")
+
+var ErrCooldownActive = errors.New("cooldown after failed quiz attempt still active")
+
+var ErrNoActiveAttempt = errors.New("quiz has no active attempt")
+
+var ErrStepLocked = errors.New("prerequisites of the step are not met")
+
+var ErrQuizAlreadyStarted = errors.New("quiz already started and not finished")
+
+var ErrQuestionAlreadyAnswered = errors.New("question already answered and the correct answers revealed")
+
+var ErrInvalidReviewQuality = errors.New("review quality must be between 0 and 5")
+
+var ErrReviewNotDue = errors.New("question is not due for review")
+
+var ErrNoWrongAnswers = errors.New("attempt has no wrong answered questions to retake")
+
+var ErrUnknownConfidence = errors.New("confidence must be low, medium or high")
+
 type Participant struct {
 	id           string
 	quizAttempts map[string][]*quizAttempt
+	certificates map[string]Certificate
+	badges       []badge.AwardedBadge
+	timezone     string
+
+	leaderboardOptOut bool
+
+	// questionRatings holds the latest rating of every rated question by question id.
+	questionRatings map[string]QuestionRating
+
+	// clock provides the creation time of new events and the time attempts expire at.
+	clock clock.Clock
 
 	eventsource.AggregateRoot
 }
 
+// UseClock creates the following events at the time of the clock.
+func (p *Participant) UseClock(c clock.Clock) {
+	p.clock = c
+}
+
 func (p *Participant) apply(eventToApply eventsource.Event, isPersisted bool) error {
 
 	switch e := eventToApply.(type) {
@@ -34,6 +81,15 @@ func (p *Participant) apply(eventToApply eventsource.Event, isPersisted bool) er
 			providedAnswers:           nil,
 			requiredQuestionsAnswered: e.RequiredQuestionsAnswered,
 			completed:                 false,
+			startedAt:                 e.CreatedAt,
+			timeLimitMins:             e.TimeLimitMins,
+			questionOrder:             e.QuestionOrder,
+			answerOrders:              e.AnswerOrders,
+			drawnQuestionIDs:          e.DrawnQuestionIDs,
+			adaptive:                  e.Adaptive,
+			practice:                  e.Practice,
+			retakeOfAttemptID:         e.RetakeOfAttemptID,
+			answersLocked:             e.AnswersLocked,
 		})
 
 	case event.SelectedAnswer:
@@ -48,12 +104,47 @@ func (p *Participant) apply(eventToApply eventsource.Event, isPersisted bool) er
 			return fmt.Errorf("can not selected an answer for lastQuizAttempt %v that is already completed", e.QuizID)
 		}
 
+		if quiz.isExpiredAt(e.CreatedAt) {
+			return fmt.Errorf("can not select an answer for quiz %v: %w", e.QuizID, ErrTimeLimitExceeded)
+		}
+
+		if !quiz.isQuestionDrawn(e.QuestionID) {
+			return fmt.Errorf("question %v was not drawn for the attempt of quiz %v", e.QuestionID, e.QuizID)
+		}
+
+		if quiz.answersLocked && quiz.isAnswered(e.QuestionID) {
+			return fmt.Errorf("can not change the answer to question %v of quiz %v: %w", e.QuestionID, e.QuizID, ErrQuestionAlreadyAnswered)
+		}
+
+		if e.Confidence != "" && !calculator.IsConfidence(e.Confidence) {
+			return fmt.Errorf("can not select an answer with confidence %q: %w", e.Confidence, ErrUnknownConfidence)
+		}
+
 		quiz.providedAnswers = append(quiz.providedAnswers, ProvidedAnswer{
 			QuestionID: e.QuestionID,
 			AnswerID:   e.AnswerID,
 			IsCorrect:  e.IsCorrect,
+			Confidence: e.Confidence,
 		})
 
+	case event.AnswerRegraded:
+		err := p.regradeAnswer(e)
+		if err != nil {
+			return err
+		}
+
+	case event.HintRequested:
+		err := p.useHint(e)
+		if err != nil {
+			return err
+		}
+
+	case event.QuestionViewed:
+		err := p.viewQuestion(e)
+		if err != nil {
+			return err
+		}
+
 	case event.FinishedQuiz:
 		quizAttempts, ok := p.quizAttempts[e.QuizID]
 		if !ok {
@@ -67,7 +158,86 @@ func (p *Participant) apply(eventToApply eventsource.Event, isPersisted bool) er
 			return err
 		}
 
+		if lastQuizAttempt.isExpiredAt(e.CreatedAt) {
+			return fmt.Errorf("can not finish quiz %v: %w", e.QuizID, ErrTimeLimitExceeded)
+		}
+
 		lastQuizAttempt.completed = true
+		lastQuizAttempt.finishedAt = e.CreatedAt
+		lastQuizAttempt.abilityScore = e.AbilityScore
+
+	case event.QuizTimedOut:
+		quizAttempts, ok := p.quizAttempts[e.QuizID]
+		if !ok {
+			return fmt.Errorf("lastQuizAttempt %v not found", e.QuizID)
+		}
+
+		lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+		if lastQuizAttempt.completed {
+			return fmt.Errorf("can not time out quiz %v that is already completed", e.QuizID)
+		}
+
+		lastQuizAttempt.completed = true
+		lastQuizAttempt.timedOut = true
+		lastQuizAttempt.finishedAt = lastQuizAttempt.deadline()
+		lastQuizAttempt.abilityScore = e.AbilityScore
+
+	case event.ReviewedQuestion:
+		if e.Quality < calculator.ReviewQualityMin || e.Quality > calculator.ReviewQualityMax {
+			return fmt.Errorf("can not review question %v with quality %d: %w", e.QuestionID, e.Quality, ErrInvalidReviewQuality)
+		}
+
+	case event.CourseCompleted:
+		if _, ok := p.certificates[e.CourseID]; ok {
+			return fmt.Errorf("course %v is already completed", e.CourseID)
+		}
+
+		p.certificates[e.CourseID] = Certificate{
+			ID:            e.CertificateID,
+			ParticipantID: e.GetAggregateID(),
+			CourseID:      e.CourseID,
+			CompletedAt:   e.CreatedAt,
+			Signature:     e.Signature,
+		}
+
+	case event.BadgeAwarded:
+		if p.hasBadge(e.CourseID, e.BadgeID) {
+			return fmt.Errorf("badge %v of course %v is already awarded", e.BadgeID, e.CourseID)
+		}
+
+		p.badges = append(p.badges, badge.AwardedBadge{
+			CourseID:  e.CourseID,
+			BadgeID:   e.BadgeID,
+			AwardedAt: e.CreatedAt,
+		})
+
+	case event.TimezoneSet:
+		_, err := loadLocation(e.Timezone)
+		if err != nil {
+			return err
+		}
+
+		p.timezone = e.Timezone
+
+	case event.LeaderboardOptOutSet:
+		p.leaderboardOptOut = e.OptOut
+
+	case event.QuestionRated:
+		if e.Stars < QuestionRatingMinStars || e.Stars > QuestionRatingMaxStars {
+			return fmt.Errorf("can not rate question %v with %d stars: %w", e.QuestionID, e.Stars, ErrInvalidQuestionRating)
+		}
+
+		p.questionRatings[e.QuestionID] = QuestionRating{
+			QuizID:     e.QuizID,
+			QuestionID: e.QuestionID,
+			Stars:      e.Stars,
+		}
+
+	case event.QuestionIssueReported:
+		err := moderation.ValidateIssue(e.Category, e.Text)
+		if err != nil {
+			return fmt.Errorf("can not report issue of question %v: %w", e.QuestionID, err)
+		}
 
 	default:
 		panic(fmt.Sprintf("unknown event type %s", reflect.TypeOf(eventToApply)))
@@ -91,25 +261,201 @@ func (p *Participant) ensureQuizNotStarted(id string) error {
 		quiz := quizAttempts[quizAttemptCount-1]
 
 		if quiz.QuizID == id && quiz.IsOngoing() {
-			return fmt.Errorf("quiz '%s' already started and not finished", quiz.QuizID)
+			return fmt.Errorf("quiz '%s': %w", quiz.QuizID, ErrQuizAlreadyStarted)
 		}
 	}
 	return nil
 }
 
 func (p *Participant) StartQuiz(quizID string, requiredQuestionsAnswered []string) error {
+	return p.StartQuizWithSettings(quizID, requiredQuestionsAnswered, AttemptSettings{})
+}
+
+// StartQuizWithSettings starts a new attempt, for question pools the drawn questions replace the required questions
+// and adaptive attempts have no required questions. A retake draws the wrong answered questions of the retaken attempt.
+func (p *Participant) StartQuizWithSettings(quizID string, requiredQuestionsAnswered []string, settings AttemptSettings) error {
+	// an ongoing attempt is reported before the checks of a new attempt like the prerequisites or the cooldown
+	err := p.ensureQuizNotStarted(quizID)
+	if err != nil {
+		return err
+	}
+
+	seed := newAttemptSeed()
+
+	var drawnQuestionIDs []string
+	if settings.isRetake() {
+		wrongQuestionIDs, err := p.getRetakeQuestionIDs(quizID, settings.RetakeAttemptID)
+		if err != nil {
+			return err
+		}
+
+		settings.Adaptive = false
+		drawnQuestionIDs = wrongQuestionIDs
+		requiredQuestionsAnswered = wrongQuestionIDs
+	} else if settings.Adaptive {
+		requiredQuestionsAnswered = nil
+	} else {
+		drawnQuestionIDs = settings.Quiz.DrawQuestionIDs(seed)
+		if drawnQuestionIDs != nil {
+			requiredQuestionsAnswered = drawnQuestionIDs
+		}
+	}
+
+	questionOrder, answerOrders := settings.Quiz.WithQuestions(drawnQuestionIDs).Shuffled(seed).GetOrder()
 
 	var startedQuizEvent = event.StartedQuiz{
 		EventBase:                 p.createEventBaseEvent(),
 		QuizID:                    quizID,
 		RequiredQuestionsAnswered: requiredQuestionsAnswered,
+		TimeLimitMins:             settings.TimeLimitMins,
+		QuestionOrder:             questionOrder,
+		AnswerOrders:              answerOrders,
+		DrawnQuestionIDs:          drawnQuestionIDs,
+		Adaptive:                  settings.Adaptive,
+		Practice:                  settings.isPractice(),
+		RetakeOfAttemptID:         settings.RetakeAttemptID,
+		AnswersLocked:             settings.locksAnswers(),
+	}
+
+	step, ok := settings.Course.FindStepByQuizID(quizID)
+	if ok {
+		unmetPrerequisites := settings.Course.GetUnmetPrerequisites(step, p.GetPassedQuizIDs())
+		if len(unmetPrerequisites) > 0 {
+			return fmt.Errorf("can not start quiz %v, step %v requires passed steps %v: %w", quizID, step.ID, unmetPrerequisites, ErrStepLocked)
+		}
+	}
+
+	// practice attempts and retakes are neither limited nor delayed by a cooldown
+	nextAttempt := p.GetNextAttempt(quizID, settings, startedQuizEvent.CreatedAt)
+	if !nextAttempt.Allowed && settings.isGraded() {
+		if nextAttempt.RemainingAttempts == 0 {
+			return fmt.Errorf("can not start quiz %v: %w", quizID, ErrMaxAttemptsReached)
+		}
+
+		return fmt.Errorf("can not start quiz %v before %v: %w", quizID, nextAttempt.AllowedAt.Format(time.RFC3339), ErrCooldownActive)
 	}
 
-	err := p.apply(startedQuizEvent, false)
+	err = p.apply(startedQuizEvent, false)
 
 	return err
 }
 
+// getRetakeQuestionIDs returns the wrong answered questions of the finished attempt.
+func (p *Participant) getRetakeQuestionIDs(quizID string, attemptID int) ([]string, error) {
+	quizAttempts := p.quizAttempts[quizID]
+	if attemptID < 1 || attemptID > len(quizAttempts) {
+		return nil, fmt.Errorf("attempt %d of quiz %v not found", attemptID, quizID)
+	}
+
+	retakenAttempt := quizAttempts[attemptID-1]
+	if !retakenAttempt.completed {
+		return nil, fmt.Errorf("can not retake attempt %d of quiz %v that is not finished", attemptID, quizID)
+	}
+
+	wrongQuestionIDs := retakenAttempt.getWrongQuestionIDs()
+	if len(wrongQuestionIDs) == 0 {
+		return nil, fmt.Errorf("can not retake attempt %d of quiz %v: %w", attemptID, quizID, ErrNoWrongAnswers)
+	}
+
+	return wrongQuestionIDs, nil
+}
+
+// newAttemptSeed returns a random seed that is never 0, because 0 keeps the order of the quiz definition.
+func newAttemptSeed() int64 {
+	return rand.Int63n(math.MaxInt64-1) + 1
+}
+
+// GetNextAttempt evaluates the attempt limit and the cooldown after a failed attempt of the quiz at the time now, only
+// graded attempts are counted. No attempt is allowed while an attempt of the quiz is ongoing.
+func (p *Participant) GetNextAttempt(quizID string, settings AttemptSettings, now time.Time) NextAttempt {
+	quizAttempts := p.getGradedAttempts(quizID)
+
+	remainingAttempts := -1
+	if settings.hasAttemptLimit() {
+		remainingAttempts = settings.MaxAttempts - len(quizAttempts)
+		if remainingAttempts <= 0 {
+			return NextAttempt{
+				Allowed:           false,
+				RemainingAttempts: 0,
+			}
+		}
+	}
+
+	if allQuizAttempts, ok := p.quizAttempts[quizID]; ok && p.getLatestQuizAttempt(allQuizAttempts).IsOngoing() {
+		return NextAttempt{
+			Allowed:           false,
+			RemainingAttempts: remainingAttempts,
+		}
+	}
+
+	allowedAt := now
+	if len(quizAttempts) > 0 {
+		lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+		if lastQuizAttempt.completed && !lastQuizAttempt.isPass() {
+			cooldownEnd := lastQuizAttempt.finishedAt.Add(settings.cooldown())
+			if cooldownEnd.After(now) {
+				allowedAt = cooldownEnd
+			}
+		}
+	}
+
+	return NextAttempt{
+		Allowed:           !allowedAt.After(now),
+		AllowedAt:         allowedAt,
+		RemainingAttempts: remainingAttempts,
+	}
+}
+
+func (p *Participant) getGradedAttempts(quizID string) []*quizAttempt {
+	var gradedAttempts []*quizAttempt
+	for _, quizAttempt := range p.quizAttempts[quizID] {
+		if quizAttempt.isGraded() {
+			gradedAttempts = append(gradedAttempts, quizAttempt)
+		}
+	}
+
+	return gradedAttempts
+}
+
+// TimeOutExpiredQuizzes closes every ongoing attempt whose deadline is before the current time of the clock, adaptive
+// attempts end with the ability estimated from the quiz definition. It returns the ids of the quizzes that were timed out.
+func (p *Participant) TimeOutExpiredQuizzes(quizFinder QuizFinder) ([]string, error) {
+	now := p.clock.Now()
+
+	var expiredQuizIDs []string
+
+	for quizID, quizAttempts := range p.quizAttempts {
+		lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+
+		if lastQuizAttempt.IsOngoing() && lastQuizAttempt.isExpiredAt(now) {
+			expiredQuizIDs = append(expiredQuizIDs, quizID)
+		}
+	}
+
+	// map iteration order is random, the events need a stable order
+	sort.Strings(expiredQuizIDs)
+
+	for _, quizID := range expiredQuizIDs {
+		abilityScore, err := p.getTimedOutAbilityScore(quizID, quizFinder)
+		if err != nil {
+			return nil, err
+		}
+
+		quizTimedOutEvent := event.QuizTimedOut{
+			EventBase:    p.createEventBaseEvent(),
+			QuizID:       quizID,
+			AbilityScore: abilityScore,
+		}
+
+		err = p.apply(quizTimedOutEvent, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return expiredQuizIDs, nil
+}
+
 func (p *Participant) FinishQuiz(quizID string) error {
 	finishedQuizEvent := event.FinishedQuiz{
 		EventBase: p.createEventBaseEvent(),
@@ -122,11 +468,18 @@ func (p *Participant) FinishQuiz(quizID string) error {
 }
 
 func (p *Participant) SelectQuizAnswer(quizID string, questionID string, answerID string, isCorrect bool) error {
+	return p.SelectQuizAnswerWithConfidence(quizID, questionID, answerID, isCorrect, "")
+}
+
+// SelectQuizAnswerWithConfidence selects the answer with the confidence of the participant, an empty confidence
+// selects the answer without confidence.
+func (p *Participant) SelectQuizAnswerWithConfidence(quizID string, questionID string, answerID string, isCorrect bool, confidence string) error {
 	selectedAnswerEvent := event.SelectedAnswer{
 		QuizID:     quizID,
 		QuestionID: questionID,
 		AnswerID:   answerID,
 		IsCorrect:  isCorrect,
+		Confidence: confidence,
 		EventBase:  p.createEventBaseEvent(),
 	}
 
@@ -135,11 +488,25 @@ func (p *Participant) SelectQuizAnswer(quizID string, questionID string, answerI
 	return err
 }
 
+// ReviewQuestion answers a question of the review queue, the answer does not belong to any quiz attempt.
+func (p *Participant) ReviewQuestion(quizID string, questionID string, answerID string, isCorrect bool, quality int) error {
+	reviewedQuestionEvent := event.ReviewedQuestion{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		AnswerID:   answerID,
+		IsCorrect:  isCorrect,
+		Quality:    quality,
+		EventBase:  p.createEventBaseEvent(),
+	}
+
+	return p.apply(reviewedQuestionEvent, false)
+}
+
 func (p *Participant) createEventBaseEvent() eventsource.EventBase {
 	return eventsource.EventBase{
 		AggregateID: p.id,
 		Version:     p.GetCurrentVersion(),
-		CreatedAt:   time.Now(),
+		CreatedAt:   p.clock.Now(),
 	}
 }
 
@@ -197,6 +564,63 @@ func (p *Participant) GetValidAttemptID(quizID string, attemptIDOrLatest string)
 	return attemptIDNumber, nil
 }
 
+// GetActiveAttemptID returns the id of the ongoing attempt of the quiz or ErrNoActiveAttempt.
+func (p *Participant) GetActiveAttemptID(quizID string) (int, error) {
+	quizAttempts, ok := p.quizAttempts[quizID]
+	if !ok || !p.getLatestQuizAttempt(quizAttempts).IsOngoing() {
+		return 0, fmt.Errorf("quiz %v: %w", quizID, ErrNoActiveAttempt)
+	}
+
+	return len(quizAttempts), nil
+}
+
+// GetAttemptQuiz returns the quiz with questions and answers in the order the participant saw them in the attempt.
+func (p *Participant) GetAttemptQuiz(quiz course.StepQuiz, attemptID int) (course.StepQuiz, error) {
+	quizAttempts := p.quizAttempts[quiz.ID]
+	if attemptID < 1 || attemptID > len(quizAttempts) {
+		return course.StepQuiz{}, fmt.Errorf("attempt %d of quiz %v not found", attemptID, quiz.ID)
+	}
+
+	return quizAttempts[attemptID-1].orderQuiz(quiz), nil
+}
+
+// GetAttemptDrawnQuestionIDs returns the questions drawn for the attempt, nil if the quiz was no question pool.
+func (p *Participant) GetAttemptDrawnQuestionIDs(quizID string, attemptID int) ([]string, error) {
+	quizAttempts := p.quizAttempts[quizID]
+	if attemptID < 1 || attemptID > len(quizAttempts) {
+		return nil, fmt.Errorf("attempt %d of quiz %v not found", attemptID, quizID)
+	}
+
+	return quizAttempts[attemptID-1].drawnQuestionIDs, nil
+}
+
+// GetPassedQuizIDs returns the ids of all quizzes with at least one passed graded attempt.
+func (p *Participant) GetPassedQuizIDs() map[string]bool {
+	passedQuizIDs := map[string]bool{}
+
+	for quizID, quizAttempts := range p.quizAttempts {
+		for _, quizAttempt := range quizAttempts {
+			if quizAttempt.isGradedPass() {
+				passedQuizIDs[quizID] = true
+			}
+		}
+	}
+
+	return passedQuizIDs
+}
+
+// GetAttemptedQuizIDs returns the sorted ids of all quizzes with at least one attempt.
+func (p *Participant) GetAttemptedQuizIDs() []string {
+	quizIDs := make([]string, 0, len(p.quizAttempts))
+	for quizID := range p.quizAttempts {
+		quizIDs = append(quizIDs, quizID)
+	}
+
+	sort.Strings(quizIDs)
+
+	return quizIDs
+}
+
 func (p *Participant) GetQuizAttemptCount(quizID string) int {
 	return p.getQuizAttemptCountByQuizID(quizID)
 }
@@ -2,16 +2,25 @@ package participant_test
 
 import (
 	"encoding/json"
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/moderation"
 	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/event"
 	"learn-to-code/internal/infrastructure/go/util/err"
 	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// now is the time the participants of the tests act at.
+var now = time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+
 func TestParticipant_GetId_CorrectSize(t *testing.T) {
 	p := err.PanicIfError1(participant.New())
 
@@ -392,6 +401,822 @@ func TestParticipant_GetAttemptID_returnsErrorForUnknownID(t *testing.T) {
 	}
 }
 
+func TestParticipant_SelectQuizAnswer_FailsAfterTimeLimitExceeded(t *testing.T) {
+	p := createParticipantWithTimedQuizStartedAt("quiz-id", now.Add(-11*time.Minute), 10)
+
+	selectErr := p.SelectQuizAnswer("quiz-id", inmemory.FirstQuestionID, inmemory.FirstAnswerID, true)
+
+	if !errors.Is(selectErr, participant.ErrTimeLimitExceeded) {
+		t.Fatalf("expected time limit exceeded error for an answer after the deadline, got %v", selectErr)
+	}
+}
+
+func TestParticipant_SelectQuizAnswer_SucceedsWithinTimeLimit(t *testing.T) {
+	p := createParticipantWithTimedQuizStartedAt("quiz-id", now.Add(-5*time.Minute), 10)
+
+	err.PanicIfError(p.SelectQuizAnswer("quiz-id", inmemory.FirstQuestionID, inmemory.FirstAnswerID, true))
+}
+
+func TestParticipant_TimeOutExpiredQuizzes_CreatesQuizTimedOutEvent(t *testing.T) {
+	p := createParticipantWithTimedQuizStartedAt("quiz-id", now.Add(-11*time.Minute), 10)
+
+	timedOutQuizIDs := err.PanicIfError1(p.TimeOutExpiredQuizzes(inmemory.NewCourseRepository()))
+
+	if len(timedOutQuizIDs) != 1 || timedOutQuizIDs[0] != "quiz-id" {
+		t.Fatalf("expected quiz-id to be timed out, got %v", timedOutQuizIDs)
+	}
+
+	newEvents := p.GetNewEventsAndUpdatePersistedVersion()
+	if _, ok := newEvents[len(newEvents)-1].(event.QuizTimedOut); !ok {
+		t.Fatalf("expected the last event to be QuizTimedOut, got %T", newEvents[len(newEvents)-1])
+	}
+
+	err.PanicIfError(p.StartQuiz("quiz-id", nil))
+}
+
+func TestParticipant_TimeOutExpiredQuizzes_IgnoresQuizzesWithoutTimeLimit(t *testing.T) {
+	p := createParticipantWithTimedQuizStartedAt("quiz-id", now.Add(-24*time.Hour), 0)
+
+	timedOutQuizIDs := err.PanicIfError1(p.TimeOutExpiredQuizzes(inmemory.NewCourseRepository()))
+
+	if len(timedOutQuizIDs) != 0 {
+		t.Fatalf("expected no timed out quiz for a quiz without time limit, got %v", timedOutQuizIDs)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_FailsWhenMaxAttemptsReached(t *testing.T) {
+	p, quizID := createParticipantWithFinishedQuizzes(2)
+
+	startErr := p.StartQuizWithSettings(quizID, nil, participant.AttemptSettings{MaxAttempts: 2})
+	if !errors.Is(startErr, participant.ErrMaxAttemptsReached) {
+		t.Fatalf("expected max attempts error, got %v", startErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_SucceedsBelowMaxAttempts(t *testing.T) {
+	p, quizID := createParticipantWithFinishedQuizzes(1)
+
+	err.PanicIfError(p.StartQuizWithSettings(quizID, nil, participant.AttemptSettings{MaxAttempts: 2}))
+}
+
+func TestParticipant_StartQuizWithSettings_FailsDuringCooldownAfterFailedAttempt(t *testing.T) {
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, now.Add(-5*time.Minute), false)
+
+	startErr := p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{CooldownMins: 10})
+	if !errors.Is(startErr, participant.ErrCooldownActive) {
+		t.Fatalf("expected cooldown error, got %v", startErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_FailsWhenStepIsLocked(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	c := err.PanicIfError1(inmemory.NewCourseRepository().FindByQuizID(inmemory.QuizIDJavaScriptAdvanced))
+	quiz, _ := c.FindQuiz(inmemory.QuizIDJavaScriptAdvanced)
+
+	startErr := p.StartQuizWithSettings(inmemory.QuizIDJavaScriptAdvanced, nil, participant.NewCourseAttemptSettings(c, quiz))
+	if !errors.Is(startErr, participant.ErrStepLocked) {
+		t.Fatalf("expected step locked error, got %v", startErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_OngoingAttempt_FailsAsAlreadyStartedBeforeAttemptLimit(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{MaxAttempts: 1}))
+
+	startErr := p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{MaxAttempts: 1})
+	if !errors.Is(startErr, participant.ErrQuizAlreadyStarted) {
+		t.Fatalf("expected already started error, got %v", startErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_SucceedsWhenPrerequisitesArePassed(t *testing.T) {
+	c := err.PanicIfError1(inmemory.NewCourseRepository().FindByQuizID(inmemory.QuizIDJavaScriptAdvanced))
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDJavaScriptBasics, now.Add(-1*time.Minute), true)
+	quiz, _ := c.FindQuiz(inmemory.QuizIDJavaScriptAdvanced)
+
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDJavaScriptAdvanced, nil, participant.NewCourseAttemptSettings(c, quiz)))
+}
+
+func TestParticipant_StartQuizWithSettings_SucceedsAfterCooldown(t *testing.T) {
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, now.Add(-11*time.Minute), false)
+
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{CooldownMins: 10}))
+}
+
+func TestParticipant_StartQuizWithSettings_NoCooldownAfterPassedAttempt(t *testing.T) {
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, now.Add(-1*time.Minute), true)
+
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{CooldownMins: 10}))
+}
+
+func TestParticipant_GetNextAttempt_ReturnsEndOfCooldown(t *testing.T) {
+	finishedAt := now.Add(-5 * time.Minute)
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, finishedAt, false)
+
+	nextAttempt := p.GetNextAttempt(inmemory.QuizIDEssentialsOfTheWeb, participant.AttemptSettings{MaxAttempts: 3, CooldownMins: 10}, now)
+
+	if nextAttempt.Allowed {
+		t.Fatalf("expected next attempt not to be allowed during cooldown")
+	}
+
+	if !nextAttempt.AllowedAt.Equal(finishedAt.Add(10 * time.Minute)) {
+		t.Fatalf("expected next attempt allowed at %v, got %v", finishedAt.Add(10*time.Minute), nextAttempt.AllowedAt)
+	}
+
+	if nextAttempt.RemainingAttempts != 2 {
+		t.Fatalf("expected 2 remaining attempts, got %d", nextAttempt.RemainingAttempts)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_RecordsOrderOfAttempt(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	quiz := createQuiz(10)
+
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, nil, participant.NewAttemptSettings(quiz)))
+
+	startedQuiz := p.GetNewEventsAndUpdatePersistedVersion()[1].(event.StartedQuiz)
+	if len(startedQuiz.QuestionOrder) != 10 {
+		t.Fatalf("expected the order of 10 questions, got %v", startedQuiz.QuestionOrder)
+	}
+
+	attemptQuiz := err.PanicIfError1(p.GetAttemptQuiz(quiz, 1))
+	for i, question := range attemptQuiz.Questions {
+		if question.ID != startedQuiz.QuestionOrder[i] {
+			t.Fatalf("expected question %v at position %d, got %v", startedQuiz.QuestionOrder[i], i, question.ID)
+		}
+	}
+}
+
+func TestParticipant_GetAttemptQuiz_KeepsOrderOfAttemptWhenQuizChanges(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	quiz := createQuiz(10)
+
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, nil, participant.NewAttemptSettings(quiz)))
+	attemptQuestions := err.PanicIfError1(p.GetAttemptQuiz(quiz, 1)).Questions
+
+	changedQuiz := quiz
+	changedQuiz.Questions = append([]course.QuizQuestion{{ID: newUUID()}}, quiz.Questions...)
+
+	changedAttemptQuestions := err.PanicIfError1(p.GetAttemptQuiz(changedQuiz, 1)).Questions
+	for i, question := range attemptQuestions {
+		if changedAttemptQuestions[i].ID != question.ID {
+			t.Fatalf("expected question %v at position %d, got %v", question.ID, i, changedAttemptQuestions[i].ID)
+		}
+	}
+}
+
+func TestParticipant_GetActiveAttemptID_FailsForFinishedQuiz(t *testing.T) {
+	p, quizID := createParticipantWithFinishedQuizzes(1)
+
+	_, activeErr := p.GetActiveAttemptID(quizID)
+	if !errors.Is(activeErr, participant.ErrNoActiveAttempt) {
+		t.Fatalf("expected no active attempt error, got %v", activeErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_DrawnQuestionsBecomeRequired(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	questionPool := createQuestionPool(10, 3)
+
+	err.PanicIfError(p.StartQuizWithSettings(questionPool.ID, []string{"ignored"}, participant.NewAttemptSettings(questionPool)))
+
+	startedQuiz := p.GetNewEventsAndUpdatePersistedVersion()[1].(event.StartedQuiz)
+	if len(startedQuiz.DrawnQuestionIDs) != 3 {
+		t.Fatalf("expected 3 drawn questions, got %v", startedQuiz.DrawnQuestionIDs)
+	}
+
+	if strings.Join(startedQuiz.RequiredQuestionsAnswered, ",") != strings.Join(startedQuiz.DrawnQuestionIDs, ",") {
+		t.Fatalf("expected drawn questions %v to be required, got %v", startedQuiz.DrawnQuestionIDs, startedQuiz.RequiredQuestionsAnswered)
+	}
+}
+
+func TestParticipant_SelectQuizAnswer_FailsForQuestionNotDrawn(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	questionPool := createQuestionPool(10, 3)
+
+	err.PanicIfError(p.StartQuizWithSettings(questionPool.ID, nil, participant.NewAttemptSettings(questionPool)))
+
+	attemptID := err.PanicIfError1(p.GetActiveAttemptID(questionPool.ID))
+	drawnQuestionIDs := err.PanicIfError1(p.GetAttemptDrawnQuestionIDs(questionPool.ID, attemptID))
+
+	for _, question := range questionPool.Questions {
+		isDrawn := strings.Contains(strings.Join(drawnQuestionIDs, ","), question.ID)
+		selectErr := p.SelectQuizAnswer(questionPool.ID, question.ID, "answer", true)
+
+		if isDrawn && selectErr != nil {
+			t.Fatalf("expected drawn question %s to accept an answer, got %v", question.ID, selectErr)
+		}
+		if !isDrawn && selectErr == nil {
+			t.Fatalf("expected question %s that was not drawn to reject an answer", question.ID)
+		}
+	}
+}
+
+func TestParticipant_GetNextAdaptiveQuestion_StartsWithMediumAndIncreasesDifficultyAfterCorrectAnswer(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	adaptiveQuiz := createAdaptiveQuiz()
+
+	err.PanicIfError(p.StartQuizWithSettings(adaptiveQuiz.ID, nil, participant.NewAttemptSettings(adaptiveQuiz)))
+
+	firstQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	if firstQuestion.Question.Difficulty != "medium" {
+		t.Fatalf("expected first question to be medium, got %s", firstQuestion.Question.Difficulty)
+	}
+
+	err.PanicIfError(p.SelectQuizAnswer(adaptiveQuiz.ID, firstQuestion.Question.ID, "answer", true))
+
+	secondQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	if secondQuestion.Question.Difficulty != "hard" {
+		t.Fatalf("expected question after correct answer to be hard, got %s", secondQuestion.Question.Difficulty)
+	}
+}
+
+func TestParticipant_FinishSettledAdaptiveQuiz_FinishesOnceAbilitySettled(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	adaptiveQuiz := createAdaptiveQuiz()
+
+	err.PanicIfError(p.StartQuizWithSettings(adaptiveQuiz.ID, nil, participant.NewAttemptSettings(adaptiveQuiz)))
+
+	finished := false
+	for answerCount := 0; !finished; answerCount++ {
+		if answerCount == len(adaptiveQuiz.Questions) {
+			t.Fatalf("expected adaptive attempt to finish before all questions are answered")
+		}
+
+		nextQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+		isCorrect := nextQuestion.Question.Difficulty != "hard"
+
+		err.PanicIfError(p.SelectQuizAnswer(adaptiveQuiz.ID, nextQuestion.Question.ID, "answer", isCorrect))
+		finished = err.PanicIfError1(p.FinishSettledAdaptiveQuiz(adaptiveQuiz))
+	}
+
+	nextQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	if !nextQuestion.Finished || nextQuestion.AbilityScore <= 0 || nextQuestion.AbilityScore >= 1 {
+		t.Fatalf("expected finished attempt with ability between medium and hard, got %v", nextQuestion)
+	}
+}
+
+func TestParticipant_SelectAdaptiveQuizAnswer_RejectsQuestionOtherThanNext(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	adaptiveQuiz := createAdaptiveQuiz()
+
+	err.PanicIfError(p.StartQuizWithSettings(adaptiveQuiz.ID, nil, participant.NewAttemptSettings(adaptiveQuiz)))
+
+	nextQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	for _, question := range adaptiveQuiz.Questions {
+		if question.ID == nextQuestion.Question.ID {
+			continue
+		}
+
+		selectErr := p.SelectAdaptiveQuizAnswer(adaptiveQuiz, question.ID, "answer", true, "")
+		if !errors.Is(selectErr, participant.ErrNotNextAdaptiveQuestion) {
+			t.Fatalf("expected answer to question %v to be rejected, got %v", question.ID, selectErr)
+		}
+	}
+
+	err.PanicIfError(p.SelectAdaptiveQuizAnswer(adaptiveQuiz, nextQuestion.Question.ID, "answer", true, ""))
+}
+
+func TestParticipant_FinishAdaptiveQuiz_FailsBeforeAbilitySettled(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	adaptiveQuiz := createAdaptiveQuiz()
+
+	err.PanicIfError(p.StartQuizWithSettings(adaptiveQuiz.ID, nil, participant.NewAttemptSettings(adaptiveQuiz)))
+	nextQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	err.PanicIfError(p.SelectAdaptiveQuizAnswer(adaptiveQuiz, nextQuestion.Question.ID, "answer", true, ""))
+
+	finishErr := p.FinishAdaptiveQuiz(adaptiveQuiz)
+	if !errors.Is(finishErr, participant.ErrAdaptiveAttemptNotSettled) {
+		t.Fatalf("expected not settled error, got %v", finishErr)
+	}
+
+	if p.GetPassedQuizIDs()[adaptiveQuiz.ID] {
+		t.Fatalf("expected a single correct answer not to pass the adaptive quiz")
+	}
+}
+
+func TestParticipant_TimeOutExpiredQuizzes_AdaptiveAttemptEndsWithAbilityScore(t *testing.T) {
+	adaptiveQuiz := createAdaptiveQuiz()
+	settings := participant.NewAttemptSettings(adaptiveQuiz)
+	settings.TimeLimitMins = 10
+
+	b := participantbuilder.New(now.Add(-20*time.Minute)).StartQuizWithSettings(adaptiveQuiz.ID, nil, settings)
+	p := b.Build()
+	firstQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	err.PanicIfError(p.SelectAdaptiveQuizAnswer(adaptiveQuiz, firstQuestion.Question.ID, "answer", true, ""))
+
+	b.At(now)
+	err.PanicIfError1(p.TimeOutExpiredQuizzes(quizFinder{quiz: adaptiveQuiz}))
+
+	nextQuestion := err.PanicIfError1(p.GetNextAdaptiveQuestion(adaptiveQuiz))
+	if !nextQuestion.Finished || nextQuestion.AbilityScore != 1 {
+		t.Fatalf("expected the timed out attempt to end with the ability 1 after a correct medium answer, got %v", nextQuestion)
+	}
+}
+
+func TestParticipant_ReviewQuestion_FailsForInvalidQuality(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	reviewErr := p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, false, 6)
+	if !errors.Is(reviewErr, participant.ErrInvalidReviewQuality) {
+		t.Fatalf("expected invalid review quality error, got %v", reviewErr)
+	}
+}
+
+func TestParticipant_ReviewQuestion_DoesNotCountAsAttempt(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true, 4))
+
+	if p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb) != 0 {
+		t.Fatalf("expected a review not to create a quiz attempt")
+	}
+}
+
+func TestParticipant_SetTimezone_StoresTimezone(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	if p.GetTimezone() != participant.DefaultTimezone {
+		t.Fatalf("expected default timezone, got %v", p.GetTimezone())
+	}
+
+	err.PanicIfError(p.SetTimezone("Europe/Berlin"))
+
+	if p.GetTimezone() != "Europe/Berlin" || p.GetLocation().String() != "Europe/Berlin" {
+		t.Fatalf("expected timezone Europe/Berlin, got %v", p.GetTimezone())
+	}
+}
+
+func TestParticipant_SetTimezone_FailsForInvalidTimezone(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	setErr := p.SetTimezone("Mars/Olympus_Mons")
+	if !errors.Is(setErr, participant.ErrInvalidTimezone) {
+		t.Fatalf("expected invalid timezone error, got %v", setErr)
+	}
+}
+
+func TestParticipant_SetLeaderboardOptOut_CanBeReverted(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	err.PanicIfError(p.SetLeaderboardOptOut(true))
+	if !p.IsLeaderboardOptOut() {
+		t.Fatalf("expected participant to be opted out")
+	}
+
+	err.PanicIfError(p.SetLeaderboardOptOut(false))
+	if p.IsLeaderboardOptOut() {
+		t.Fatalf("expected participant to be shown on leaderboards again")
+	}
+}
+
+func TestParticipant_RateQuestion_ReplacesPreviousRating(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	err.PanicIfError(p.RateQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, 2))
+	err.PanicIfError(p.RateQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, 4))
+
+	questionRatings := p.GetQuestionRatings()
+	expected := participant.QuestionRating{QuizID: inmemory.QuizIDEssentialsOfTheWeb, QuestionID: inmemory.FirstQuestionID, Stars: 4}
+	if len(questionRatings) != 1 || questionRatings[0] != expected {
+		t.Fatalf("expected a single rating with 4 stars, got %v", questionRatings)
+	}
+}
+
+func TestParticipant_RateQuestion_FailsForInvalidStars(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	for _, stars := range []int{0, 6} {
+		rateErr := p.RateQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, stars)
+		if !errors.Is(rateErr, participant.ErrInvalidQuestionRating) {
+			t.Fatalf("expected invalid question rating error for %d stars, got %v", stars, rateErr)
+		}
+	}
+}
+
+func TestParticipant_ReportQuestionIssue_ReturnsOpenReport(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	reportID := err.PanicIfError1(p.ReportQuestionIssue(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, moderation.CategoryTypo, "Missing letter"))
+
+	reports := p.GetQuestionIssueReports()
+	if len(reports) != 1 || reports[0].ID != reportID || reports[0].ParticipantID != p.GetID() || reports[0].Status != moderation.StatusOpen {
+		t.Fatalf("expected a single open report %v, got %v", reportID, reports)
+	}
+}
+
+func TestParticipant_ReportQuestionIssue_FailsForUnknownCategory(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	_, reportErr := p.ReportQuestionIssue(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, "spam", "Buy now")
+	if !errors.Is(reportErr, moderation.ErrUnknownIssueCategory) {
+		t.Fatalf("expected unknown category error, got %v", reportErr)
+	}
+}
+
+func TestParticipant_PlanRegrade_ReportsFlippedAttemptWithoutEvents(t *testing.T) {
+	c := createRegradeCourse(true)
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "answer", false))
+	err.PanicIfError(p.FinishQuiz("quiz"))
+	eventCount := len(p.GetEvents())
+
+	regrade := p.PlanRegrade(c)
+
+	if len(regrade.Answers) != 1 || !regrade.Answers[0].IsCorrect || regrade.Answers[0].AttemptID != 1 {
+		t.Fatalf("expected the wrong answer to be regraded as correct, got %v", regrade.Answers)
+	}
+
+	if len(regrade.Attempts) != 1 || regrade.Attempts[0].WasPass || !regrade.Attempts[0].IsPass {
+		t.Fatalf("expected the failed attempt to pass after the regrade, got %v", regrade.Attempts)
+	}
+
+	if len(p.GetEvents()) != eventCount {
+		t.Fatalf("expected the plan to emit no events")
+	}
+}
+
+func TestParticipant_RegradeAnswers_ChangesPassedQuizzes(t *testing.T) {
+	c := createRegradeCourse(true)
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "answer", false))
+	err.PanicIfError(p.FinishQuiz("quiz"))
+
+	_ = err.PanicIfError1(p.RegradeAnswers(c))
+
+	if !p.GetPassedQuizIDs()["quiz"] {
+		t.Fatalf("expected the regraded quiz to be passed")
+	}
+
+	if !p.PlanRegrade(c).IsEmpty() {
+		t.Fatalf("expected nothing to regrade after the regrade")
+	}
+}
+
+func TestParticipant_RegradeAnswers_FailsForAnswerNotSelected(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+
+	events := append(p.GetEvents(), event.AnswerRegraded{QuizID: "quiz", AttemptID: 1, QuestionID: "question", AnswerID: "answer", IsCorrect: true})
+
+	_, regradeErr := participant.NewFromEvents(events, true)
+	if regradeErr == nil {
+		t.Fatalf("expected regrading an answer that was not selected to fail")
+	}
+}
+
+func TestParticipant_GetAnswerFeedback_ReturnsExplanationAndCorrectAnswers(t *testing.T) {
+	c := createRegradeCourse(false)
+	quiz, _ := c.FindQuiz("quiz")
+	quiz.Questions[0].Answers = append(quiz.Questions[0].Answers, course.QuizAnswer{ID: "correct", IsCorrect: true})
+	quiz.Questions[0].Answers[0].Description = "Not quite"
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "answer", false))
+
+	answerFeedback := err.PanicIfError1(p.GetAnswerFeedback(quiz, "question"))
+
+	if answerFeedback.IsCorrect || answerFeedback.Explanation != "Not quite" {
+		t.Fatalf("expected wrong answer feedback with explanation, got %v", answerFeedback)
+	}
+
+	if len(answerFeedback.CorrectAnswerIDs) != 1 || answerFeedback.CorrectAnswerIDs[0] != "correct" {
+		t.Fatalf("expected the correct answer in the feedback, got %v", answerFeedback.CorrectAnswerIDs)
+	}
+}
+
+func TestParticipant_SelectQuizAnswer_GradedAttemptWithFeedback_RejectsChangedAnswer(t *testing.T) {
+	c := createRegradeCourse(false)
+	quiz, _ := c.FindQuiz("quiz")
+	quiz.Questions[0].Answers = append(quiz.Questions[0].Answers, course.QuizAnswer{ID: "correct", IsCorrect: true})
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings("quiz", []string{"question"}, participant.AttemptSettings{Quiz: quiz}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "answer", false))
+	answerFeedback := err.PanicIfError1(p.GetAnswerFeedback(quiz, "question"))
+
+	changedAnswerErr := p.SelectQuizAnswer("quiz", "question", answerFeedback.CorrectAnswerIDs[0], true)
+
+	if !errors.Is(changedAnswerErr, participant.ErrQuestionAlreadyAnswered) {
+		t.Fatalf("expected the changed answer to be rejected after the feedback, got %v", changedAnswerErr)
+	}
+}
+
+func TestParticipant_SelectQuizAnswer_PracticeAttempt_AcceptsChangedAnswer(t *testing.T) {
+	c := createRegradeCourse(false)
+	quiz, _ := c.FindQuiz("quiz")
+	quiz.Questions[0].Answers = append(quiz.Questions[0].Answers, course.QuizAnswer{ID: "correct", IsCorrect: true})
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings("quiz", []string{"question"}, participant.AttemptSettings{Quiz: quiz, Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "answer", false))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "question", "correct", true))
+
+	answerFeedback := err.PanicIfError1(p.GetAnswerFeedback(quiz, "question"))
+
+	if !answerFeedback.IsCorrect {
+		t.Fatalf("expected the changed answer of the practice attempt to count, got %v", answerFeedback)
+	}
+}
+
+func TestParticipant_GetAttemptProgress_CountsAnsweredQuestions(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	settings := participant.AttemptSettings{Quiz: course.StepQuiz{ID: "quiz", FeedbackDisabled: true}}
+	err.PanicIfError(p.StartQuizWithSettings("quiz", []string{"a", "b"}, settings))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "a", "a-1", true))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "a", "a-2", false))
+
+	attemptProgress := err.PanicIfError1(p.GetAttemptProgress("quiz"))
+
+	if attemptProgress.AnsweredQuestions != 1 || attemptProgress.TotalQuestions != 2 || attemptProgress.Completed {
+		t.Fatalf("expected one of two questions answered, got %v", attemptProgress)
+	}
+}
+
+func TestParticipant_GetNextAttempt_NotAllowedDuringOngoingAttempt(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, nil))
+
+	nextAttempt := p.GetNextAttempt(inmemory.QuizIDEssentialsOfTheWeb, participant.AttemptSettings{MaxAttempts: 3}, time.Now())
+
+	if nextAttempt.Allowed || !nextAttempt.AllowedAt.IsZero() || nextAttempt.RemainingAttempts != 2 {
+		t.Fatalf("expected no next attempt during the ongoing attempt, got %+v", nextAttempt)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_PracticeIgnoresMaxAttemptsAndCooldown(t *testing.T) {
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, now.Add(-1*time.Minute), false)
+	settings := participant.AttemptSettings{MaxAttempts: 1, CooldownMins: 10, Mode: participant.AttemptModePractice}
+
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, settings))
+}
+
+func TestParticipant_GetNextAttempt_DoesNotCountPracticeAttempts(t *testing.T) {
+	p, quizID := createParticipantWithFinishedQuizzes(0)
+	err.PanicIfError(p.StartQuizWithSettings(quizID, nil, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.FinishQuiz(quizID))
+
+	nextAttempt := p.GetNextAttempt(quizID, participant.AttemptSettings{MaxAttempts: 1, CooldownMins: 10}, time.Now())
+
+	if !nextAttempt.Allowed || nextAttempt.RemainingAttempts != 1 {
+		t.Fatalf("expected the exam attempt to be allowed after a practice attempt, got %+v", nextAttempt)
+	}
+}
+
+func TestParticipant_GetPassedQuizIDs_IgnoresPassedPracticeAttempts(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, true))
+	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+
+	if p.GetPassedQuizIDs()[inmemory.QuizIDEssentialsOfTheWeb] {
+		t.Fatalf("expected a practice attempt not to pass the quiz")
+	}
+
+	progress := err.PanicIfError1(p.GetAttemptProgress(inmemory.QuizIDEssentialsOfTheWeb))
+	if !progress.Practice {
+		t.Fatalf("expected the progress of a practice attempt to be marked as practice")
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_RetakeDrawsWrongAnsweredQuestions(t *testing.T) {
+	quiz := createQuiz(3)
+	quiz.FeedbackDisabled = true
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, nil, participant.AttemptSettings{Quiz: quiz, MaxAttempts: 1}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[0].ID, "answer", false))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[1].ID, "answer", true))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[2].ID, "answer", true))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[2].ID, "other-answer", false))
+	err.PanicIfError(p.FinishQuiz(quiz.ID))
+
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, nil, participant.AttemptSettings{Quiz: quiz, MaxAttempts: 1, RetakeAttemptID: 1}))
+
+	drawnQuestionIDs := err.PanicIfError1(p.GetAttemptDrawnQuestionIDs(quiz.ID, 2))
+	if !reflect.DeepEqual(drawnQuestionIDs, []string{quiz.Questions[0].ID, quiz.Questions[2].ID}) {
+		t.Fatalf("expected the wrong answered questions to be retaken, got %v", drawnQuestionIDs)
+	}
+
+	if p.SelectQuizAnswer(quiz.ID, quiz.Questions[1].ID, "answer", true) == nil {
+		t.Fatalf("expected the correct answered question not to be part of the retake")
+	}
+
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[0].ID, "answer", true))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, quiz.Questions[2].ID, "answer", true))
+	err.PanicIfError(p.FinishQuiz(quiz.ID))
+
+	if p.GetPassedQuizIDs()[quiz.ID] {
+		t.Fatalf("expected a retake not to replace the failed result of the quiz")
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_RetakeFailsWithoutWrongAnswers(t *testing.T) {
+	p := createParticipantWithQuizFinishedAt(inmemory.QuizIDEssentialsOfTheWeb, now.Add(-1*time.Minute), true)
+
+	startErr := p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, nil, participant.AttemptSettings{RetakeAttemptID: 1})
+	if !errors.Is(startErr, participant.ErrNoWrongAnswers) {
+		t.Fatalf("expected no wrong answers error, got %v", startErr)
+	}
+}
+
+func TestParticipant_StartQuizWithSettings_RetakeOfTimedOutAttemptDrawsUnansweredQuestions(t *testing.T) {
+	quiz := createQuiz(2)
+	p := participantbuilder.New(now.Add(-20*time.Minute)).
+		StartQuizWithSettings(quiz.ID, []string{quiz.Questions[0].ID, quiz.Questions[1].ID}, participant.AttemptSettings{TimeLimitMins: 10}).
+		At(now).
+		Build()
+	err.PanicIfError1(p.TimeOutExpiredQuizzes(inmemory.NewCourseRepository()))
+
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, nil, participant.AttemptSettings{Quiz: quiz, RetakeAttemptID: 1}))
+
+	drawnQuestionIDs := err.PanicIfError1(p.GetAttemptDrawnQuestionIDs(quiz.ID, 2))
+	if !reflect.DeepEqual(drawnQuestionIDs, []string{quiz.Questions[0].ID, quiz.Questions[1].ID}) {
+		t.Fatalf("expected the unanswered questions of the timed out attempt to be retaken, got %v", drawnQuestionIDs)
+	}
+}
+
+func TestParticipant_RequestHint_ReducesScoreOfCorrectAnswer(t *testing.T) {
+	quiz := createQuiz(1)
+	quiz.HintPenalty = 0.5
+	quiz.Questions[0].Hints = []string{"first hint", "second hint"}
+	questionID := quiz.Questions[0].ID
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{questionID}))
+
+	hint := err.PanicIfError1(p.RequestHint(quiz, questionID))
+	if hint.Text != "first hint" || hint.RemainingHints != 1 {
+		t.Fatalf("expected the first hint with one remaining hint, got %+v", hint)
+	}
+
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, questionID, "answer", true))
+	err.PanicIfError(p.FinishQuiz(quiz.ID))
+
+	if p.GetPassedQuizIDs()[quiz.ID] {
+		t.Fatalf("expected the hint penalty to reduce the score below the pass threshold")
+	}
+}
+
+func TestParticipant_ViewQuestion_FailsForQuestionNotDrawnAndFinishedAttempt(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	questionPool := createQuestionPool(10, 3)
+	err.PanicIfError(p.StartQuizWithSettings(questionPool.ID, nil, participant.NewAttemptSettings(questionPool)))
+
+	attemptID := err.PanicIfError1(p.GetActiveAttemptID(questionPool.ID))
+	drawnQuestionIDs := err.PanicIfError1(p.GetAttemptDrawnQuestionIDs(questionPool.ID, attemptID))
+
+	for _, question := range questionPool.Questions {
+		isDrawn := strings.Contains(strings.Join(drawnQuestionIDs, ","), question.ID)
+		viewErr := p.ViewQuestion(questionPool.ID, question.ID)
+
+		if isDrawn && viewErr != nil {
+			t.Fatalf("expected drawn question %s to be viewable, got %v", question.ID, viewErr)
+		}
+		if !isDrawn && viewErr == nil {
+			t.Fatalf("expected question %s that was not drawn to be rejected", question.ID)
+		}
+	}
+
+	for _, questionID := range drawnQuestionIDs {
+		err.PanicIfError(p.SelectQuizAnswer(questionPool.ID, questionID, "answer", true))
+	}
+	err.PanicIfError(p.FinishQuiz(questionPool.ID))
+
+	if p.ViewQuestion(questionPool.ID, drawnQuestionIDs[0]) == nil {
+		t.Fatalf("expected viewing a question of a finished attempt to fail")
+	}
+}
+
+func TestParticipant_RequestHint_FailsWithoutHintLeft(t *testing.T) {
+	quiz := createQuiz(1)
+	quiz.Questions[0].Hints = []string{"only hint"}
+	questionID := quiz.Questions[0].ID
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{questionID}))
+	err.PanicIfError1(p.RequestHint(quiz, questionID))
+
+	_, hintErr := p.RequestHint(quiz, questionID)
+	if !errors.Is(hintErr, participant.ErrNoHintLeft) {
+		t.Fatalf("expected no hint left error, got %v", hintErr)
+	}
+
+	if len(p.GetUsedHints(quiz, questionID)) != 1 {
+		t.Fatalf("expected one used hint, got %v", p.GetUsedHints(quiz, questionID))
+	}
+}
+
+func TestParticipant_SelectQuizAnswerWithConfidence_MarksConfidentWrongAnswer(t *testing.T) {
+	c := createRegradeCourse(false)
+	quiz, _ := c.FindQuiz("quiz")
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+	err.PanicIfError(p.SelectQuizAnswerWithConfidence("quiz", "question", "answer", false, "high"))
+
+	answerFeedback := err.PanicIfError1(p.GetAnswerFeedback(quiz, "question"))
+
+	if answerFeedback.Confidence != "high" || answerFeedback.CertaintyMark != -6 {
+		t.Fatalf("expected a certainty mark of -6 for a confident wrong answer, got %v", answerFeedback)
+	}
+}
+
+func TestParticipant_SelectQuizAnswerWithConfidence_FailsWithUnknownConfidence(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz("quiz", []string{"question"}))
+
+	selectErr := p.SelectQuizAnswerWithConfidence("quiz", "question", "answer", true, "certain")
+	if !errors.Is(selectErr, participant.ErrUnknownConfidence) {
+		t.Fatalf("expected unknown confidence error, got %v", selectErr)
+	}
+}
+
+func TestParseAttemptMode_DefaultsToExam(t *testing.T) {
+	mode := err.PanicIfError1(participant.ParseAttemptMode(""))
+	if mode != participant.AttemptModeExam {
+		t.Fatalf("expected exam mode, got %v", mode)
+	}
+
+	_, parseErr := participant.ParseAttemptMode("homework")
+	if !errors.Is(parseErr, participant.ErrUnknownAttemptMode) {
+		t.Fatalf("expected unknown attempt mode error, got %v", parseErr)
+	}
+}
+
+func createRegradeCourse(isCorrect bool) course.Course {
+	return course.Course{
+		ID: "course",
+		Steps: []course.Step{{
+			ID: "step",
+			Quizzes: []course.StepQuiz{{
+				ID: "quiz",
+				Questions: []course.QuizQuestion{{
+					ID:      "question",
+					Answers: []course.QuizAnswer{{ID: "answer", IsCorrect: isCorrect}},
+				}},
+			}},
+		}},
+	}
+}
+
+type quizFinder struct {
+	quiz course.StepQuiz
+}
+
+func (f quizFinder) FindQuizByID(_ string) (course.StepQuiz, error) {
+	return f.quiz, nil
+}
+
+func createAdaptiveQuiz() course.StepQuiz {
+	var questions []course.QuizQuestion
+	for _, difficulty := range []string{"easy", "medium", "hard"} {
+		for i := 0; i < 6; i++ {
+			questions = append(questions, course.QuizQuestion{ID: newUUID(), Difficulty: difficulty})
+		}
+	}
+
+	return course.StepQuiz{ID: newUUID(), Questions: questions, Adaptive: true}
+}
+
+func createQuestionPool(questionCount int, questionsPerAttempt int) course.StepQuiz {
+	questionPool := createQuiz(questionCount)
+	questionPool.QuestionsPerAttempt = questionsPerAttempt
+
+	return questionPool
+}
+
+func createQuiz(questionCount int) course.StepQuiz {
+	var questions []course.QuizQuestion
+	for i := 0; i < questionCount; i++ {
+		questions = append(questions, course.QuizQuestion{ID: newUUID()})
+	}
+
+	return course.StepQuiz{ID: newUUID(), Questions: questions}
+}
+
+// createParticipantWithQuizFinishedAt finishes an attempt of the quiz at the given time, the participant acts at now.
+func createParticipantWithQuizFinishedAt(quizID string, finishedAt time.Time, pass bool) participant.Participant {
+	return participantbuilder.New(finishedAt.Add(-1*time.Minute)).
+		StartQuiz(quizID, inmemory.FirstQuestionID).
+		SelectAnswer(quizID, inmemory.FirstQuestionID, inmemory.FirstAnswerID, pass).
+		At(finishedAt).
+		FinishQuiz(quizID).
+		At(now).
+		Build()
+}
+
+// createParticipantWithTimedQuizStartedAt starts an attempt of the quiz at the given time, the participant acts at now.
+func createParticipantWithTimedQuizStartedAt(quizID string, startedAt time.Time, timeLimitMins int) participant.Participant {
+	return participantbuilder.New(startedAt).
+		StartQuizWithSettings(quizID, nil, participant.AttemptSettings{TimeLimitMins: timeLimitMins}).
+		At(now).
+		Build()
+}
+
 func createParticipantWithFinishedQuizzes(finishedQuizCount int) (participant.Participant, string) {
 	p := err.PanicIfError1(participant.New())
 
@@ -0,0 +1,136 @@
+package activeattempt
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"time"
+)
+
+type ResumedQuestion struct {
+	Question course.QuizQuestion
+
+	// SelectedAnswerID is the latest answer, empty for unanswered questions
+	SelectedAnswerID string
+	Confidence       string
+
+	// UsedHints are the texts of the hints requested for the question in the order they were requested
+	UsedHints []string
+}
+
+// ActiveAttempt is the state of the ongoing attempt of a quiz, it contains everything needed to resume the attempt.
+type ActiveAttempt struct {
+	QuizID            string
+	AttemptID         int
+	StartedAt         time.Time
+	Adaptive          bool
+	Practice          bool
+	RetakeOfAttemptID int
+
+	// Questions are in the order of the attempt, adaptive attempts only contain the answered questions
+	Questions []ResumedQuestion
+
+	// NextQuestionID is the first unanswered question in the order of the attempt, for adaptive attempts the question
+	// chosen by the answers so far. It is empty once every question is answered.
+	NextQuestionID string
+
+	Timed bool
+
+	// RemainingTimeSecs is 0 for attempts without a time limit and for attempts past their deadline
+	RemainingTimeSecs int
+
+	UsedHintCount int
+
+	// HintPenalty is the sum of the penalties of the used hints
+	HintPenalty float64
+}
+
+// NewActiveAttempt creates the state of the ongoing attempt of the quiz or returns participant.ErrNoActiveAttempt,
+// now is used to calculate the remaining time of a timed attempt.
+func NewActiveAttempt(p participant.Participant, quiz course.StepQuiz, now time.Time) (ActiveAttempt, error) {
+	attemptID, err := p.GetActiveAttemptID(quiz.ID)
+	if err != nil {
+		return ActiveAttempt{}, err
+	}
+
+	attemptQuiz, err := p.GetAttemptQuiz(quiz, attemptID)
+	if err != nil {
+		return ActiveAttempt{}, err
+	}
+
+	aa := ActiveAttempt{
+		QuizID:    quiz.ID,
+		AttemptID: attemptID,
+	}
+
+	quizCounter := 0
+	selectedAnswers := map[string]event.SelectedAnswer{}
+	usedHintCounts := map[string]int{}
+
+	for _, generalEvent := range p.GetEvents() {
+
+		switch e := generalEvent.(type) {
+
+		case event.StartedQuiz:
+			if e.QuizID == quiz.ID {
+				quizCounter++
+				if quizCounter == attemptID {
+					aa.StartedAt = e.CreatedAt
+					aa.Adaptive = e.Adaptive
+					aa.Practice = e.Practice
+					aa.RetakeOfAttemptID = e.RetakeOfAttemptID
+					aa.Timed = e.IsTimed()
+					aa.RemainingTimeSecs = e.GetRemainingTimeSecs(now)
+				}
+			}
+
+		case event.SelectedAnswer:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				selectedAnswers[e.QuestionID] = e
+			}
+
+		case event.HintRequested:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				usedHintCounts[e.QuestionID]++
+				aa.UsedHintCount++
+				aa.HintPenalty += e.Penalty
+			}
+		}
+	}
+
+	if aa.Adaptive {
+		nextQuestion, err := p.GetNextAdaptiveQuestion(quiz)
+		if err != nil {
+			return ActiveAttempt{}, err
+		}
+
+		if !nextQuestion.Finished {
+			aa.NextQuestionID = nextQuestion.Question.ID
+		}
+	}
+
+	for _, question := range attemptQuiz.Questions {
+		selectedAnswer, answered := selectedAnswers[question.ID]
+		if aa.Adaptive && !answered {
+			continue
+		}
+
+		resumedQuestion := ResumedQuestion{
+			Question:         question,
+			SelectedAnswerID: selectedAnswer.AnswerID,
+			Confidence:       selectedAnswer.Confidence,
+		}
+
+		// hints removed from the quiz after they were used are left out
+		usedHintCount := min(usedHintCounts[question.ID], len(question.Hints))
+		resumedQuestion.UsedHints = question.Hints[:usedHintCount]
+
+		if !answered && aa.NextQuestionID == "" {
+			aa.NextQuestionID = question.ID
+		}
+
+		aa.Questions = append(aa.Questions, resumedQuestion)
+	}
+
+	return aa, nil
+}
@@ -0,0 +1,123 @@
+package activeattempt
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"slices"
+	"testing"
+	"time"
+)
+
+var quiz = course.StepQuiz{
+	ID:          "quiz",
+	HintPenalty: 0.25,
+	Questions: []course.QuizQuestion{
+		{ID: "a", Answers: []course.QuizAnswer{{ID: "a-1", IsCorrect: true}, {ID: "a-2"}}, Hints: []string{"first hint", "second hint"}},
+		{ID: "b", Answers: []course.QuizAnswer{{ID: "b-1", IsCorrect: true}, {ID: "b-2"}}},
+		{ID: "c", Answers: []course.QuizAnswer{{ID: "c-1", IsCorrect: true}, {ID: "c-2"}}},
+	},
+}
+
+func TestNewActiveAttempt_OngoingAttempt_ContainsAnswersAndNextQuestion(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a", "b", "c"}))
+	err.PanicIfError(p.SelectQuizAnswerWithConfidence(quiz.ID, "a", "a-2", false, calculator.ConfidenceHigh))
+
+	activeAttempt := err.PanicIfError1(NewActiveAttempt(p, quiz, time.Now()))
+
+	if activeAttempt.AttemptID != 1 || len(activeAttempt.Questions) != 3 {
+		t.Fatalf("expected the first attempt with every question, got %v", activeAttempt)
+	}
+
+	answered := findQuestion(activeAttempt, "a")
+	if answered.SelectedAnswerID != "a-2" || answered.Confidence != calculator.ConfidenceHigh {
+		t.Fatalf("expected the selected answer with its confidence, got %v", answered)
+	}
+
+	if activeAttempt.NextQuestionID != "b" {
+		t.Fatalf("expected b to be the next question, got %v", activeAttempt.NextQuestionID)
+	}
+}
+
+func TestNewActiveAttempt_QuestionOrder_IsTheOrderOfTheAttempt(t *testing.T) {
+	eventBase := func(version uint) eventsource.EventBase {
+		return eventsource.EventBase{AggregateID: "participant", Version: version, CreatedAt: time.Now()}
+	}
+
+	p := err.PanicIfError1(participant.NewFromEvents([]eventsource.Event{
+		event.ParticipantCreated{EventBase: eventBase(0)},
+		event.StartedQuiz{QuizID: quiz.ID, RequiredQuestionsAnswered: []string{"a", "b", "c"}, QuestionOrder: []string{"c", "a", "b"}, EventBase: eventBase(1)},
+		event.SelectedAnswer{QuizID: quiz.ID, QuestionID: "c", AnswerID: "c-1", IsCorrect: true, EventBase: eventBase(2)},
+	}, true))
+
+	activeAttempt := err.PanicIfError1(NewActiveAttempt(p, quiz, time.Now()))
+
+	var questionIDs []string
+	for _, question := range activeAttempt.Questions {
+		questionIDs = append(questionIDs, question.Question.ID)
+	}
+
+	if !slices.Equal(questionIDs, []string{"c", "a", "b"}) || activeAttempt.NextQuestionID != "a" {
+		t.Fatalf("expected the questions in the order of the attempt with a next, got %v and %v", questionIDs, activeAttempt.NextQuestionID)
+	}
+}
+
+func TestNewActiveAttempt_TimedAttempt_ContainsRemainingTime(t *testing.T) {
+	startedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+
+	p := participantbuilder.New(startedAt).
+		StartQuizWithSettings(quiz.ID, []string{"a", "b", "c"}, participant.AttemptSettings{TimeLimitMins: 10}).
+		Build()
+
+	activeAttempt := err.PanicIfError1(NewActiveAttempt(p, quiz, startedAt.Add(4*time.Minute)))
+
+	if !activeAttempt.Timed || activeAttempt.RemainingTimeSecs != 6*60 {
+		t.Fatalf("expected 6 minutes remaining, got %d secs", activeAttempt.RemainingTimeSecs)
+	}
+}
+
+func TestNewActiveAttempt_UsedHints_AreListedWithPenalty(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a", "b", "c"}))
+	err.PanicIfError1(p.RequestHint(quiz, "a"))
+	err.PanicIfError1(p.RequestHint(quiz, "a"))
+
+	activeAttempt := err.PanicIfError1(NewActiveAttempt(p, quiz, time.Now()))
+
+	if !slices.Equal(findQuestion(activeAttempt, "a").UsedHints, []string{"first hint", "second hint"}) {
+		t.Fatalf("expected both hints of question a to be used, got %v", findQuestion(activeAttempt, "a").UsedHints)
+	}
+
+	if activeAttempt.UsedHintCount != 2 || activeAttempt.HintPenalty != 0.5 {
+		t.Fatalf("expected 2 used hints with a penalty of 0.5, got %d and %v", activeAttempt.UsedHintCount, activeAttempt.HintPenalty)
+	}
+}
+
+func TestNewActiveAttempt_FinishedAttempt_ReturnsErrNoActiveAttempt(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a"}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, "a", "a-1", true))
+	err.PanicIfError(p.FinishQuiz(quiz.ID))
+
+	_, activeAttemptErr := NewActiveAttempt(p, quiz, time.Now())
+
+	if !errors.Is(activeAttemptErr, participant.ErrNoActiveAttempt) {
+		t.Fatalf("expected no active attempt error, got %v", activeAttemptErr)
+	}
+}
+
+func findQuestion(activeAttempt ActiveAttempt, questionID string) ResumedQuestion {
+	for _, question := range activeAttempt.Questions {
+		if question.Question.ID == questionID {
+			return question
+		}
+	}
+
+	panic("question " + questionID + " not found in active attempt")
+}
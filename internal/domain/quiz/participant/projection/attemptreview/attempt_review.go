@@ -0,0 +1,174 @@
+package attemptreview
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/domain/quiz/participant/projection/questiontime"
+	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+	"slices"
+	"time"
+)
+
+type ReviewedQuestion struct {
+	Question course.QuizQuestion
+
+	// SelectedAnswerID is the final answer, empty for unanswered questions
+	SelectedAnswerID string
+
+	// SelectedAnswerIDs are all answers chosen for the question in the order they were first selected
+	SelectedAnswerIDs []string
+
+	// IsCorrect is false until the question is revealed
+	IsCorrect bool
+
+	// UsedHints are the texts of the hints requested for the question in the order they were requested
+	UsedHints []string
+
+	// TimeSpentSecs is the time spent on the question with idle gaps capped, see questiontime.NewQuestionTimes
+	TimeSpentSecs int
+
+	// Revealed tells if the answer key and the explanations of the question may be shown, that is once the attempt is
+	// finished or, during an attempt that locks its answers and gives feedback, once the question is answered.
+	Revealed bool
+}
+
+type AttemptReview struct {
+	QuizID    string
+	AttemptID int
+	Finished  bool
+
+	// Questions are in the order of the attempt
+	Questions []ReviewedQuestion
+
+	// Timeline contains every selected answer of the attempt in chronological order, including changed answers
+	Timeline []AnswerChange
+}
+
+type AnswerChange struct {
+	QuestionID string
+	AnswerID   string
+
+	// IsCorrect is false until the question is revealed
+	IsCorrect  bool
+	SelectedAt time.Time
+
+	// SecsSincePrevious is the time since the start of the attempt or the previous selection
+	SecsSincePrevious int
+}
+
+// NewAttemptReview creates the review of a single attempt, the quiz is the current definition of the attempted quiz.
+func NewAttemptReview(p participant.Participant, quiz course.StepQuiz, attemptID int) (AttemptReview, error) {
+	attemptCount := p.GetQuizAttemptCount(quiz.ID)
+	if attemptID < 1 || attemptID > attemptCount {
+		return AttemptReview{}, quizattemptdetail.AttemptNotFoundError{
+			AttemptID:    attemptID,
+			AttemptCount: attemptCount,
+			QuizID:       quiz.ID,
+		}
+	}
+
+	attemptQuiz, err := p.GetAttemptQuiz(quiz, attemptID)
+	if err != nil {
+		return AttemptReview{}, err
+	}
+
+	ar := AttemptReview{
+		QuizID:    quiz.ID,
+		AttemptID: attemptID,
+	}
+
+	quizCounter := 0
+	answersLocked := false
+	selectedAnswers := map[string]event.SelectedAnswer{}
+	selectedAnswerIDs := map[string][]string{}
+	timeSpentSecs := questiontime.GetAttemptTimeSpentSecs(questiontime.NewQuestionTimes(p), quiz.ID, attemptID)
+	usedHintCounts := map[string]int{}
+	previousActivityAt := time.Time{}
+
+	for _, generalEvent := range p.GetRegradedEvents() {
+
+		switch e := generalEvent.(type) {
+
+		case event.StartedQuiz:
+			if e.QuizID == quiz.ID {
+				quizCounter++
+				if quizCounter == attemptID {
+					previousActivityAt = e.CreatedAt
+					answersLocked = e.AnswersLocked
+				}
+			}
+
+		case event.SelectedAnswer:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				secsSincePrevious := max(int(e.CreatedAt.Sub(previousActivityAt).Seconds()), 0)
+				previousActivityAt = e.CreatedAt
+
+				selectedAnswers[e.QuestionID] = e
+				if !slices.Contains(selectedAnswerIDs[e.QuestionID], e.AnswerID) {
+					selectedAnswerIDs[e.QuestionID] = append(selectedAnswerIDs[e.QuestionID], e.AnswerID)
+				}
+
+				ar.Timeline = append(ar.Timeline, AnswerChange{
+					QuestionID:        e.QuestionID,
+					AnswerID:          e.AnswerID,
+					IsCorrect:         e.IsCorrect,
+					SelectedAt:        e.CreatedAt,
+					SecsSincePrevious: secsSincePrevious,
+				})
+			}
+
+		case event.HintRequested:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				usedHintCounts[e.QuestionID]++
+			}
+
+		case event.FinishedQuiz:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				ar.Finished = true
+			}
+
+		case event.QuizTimedOut:
+			if e.QuizID == quiz.ID && quizCounter == attemptID {
+				ar.Finished = true
+			}
+		}
+	}
+
+	// answers that can still be changed must not be followed by the answer key, otherwise it could be copied into the
+	// ongoing attempt
+	revealsAnsweredQuestions := ar.Finished || (answersLocked && !quiz.FeedbackDisabled)
+
+	for _, question := range attemptQuiz.Questions {
+		reviewedQuestion := ReviewedQuestion{
+			Question:      question,
+			TimeSpentSecs: timeSpentSecs[question.ID],
+			Revealed:      ar.Finished,
+		}
+
+		// hints removed from the quiz after they were used are left out
+		usedHintCount := min(usedHintCounts[question.ID], len(question.Hints))
+		reviewedQuestion.UsedHints = question.Hints[:usedHintCount]
+
+		selectedAnswer, ok := selectedAnswers[question.ID]
+		if ok {
+			reviewedQuestion.SelectedAnswerID = selectedAnswer.AnswerID
+			reviewedQuestion.SelectedAnswerIDs = selectedAnswerIDs[question.ID]
+			reviewedQuestion.Revealed = revealsAnsweredQuestions
+
+			if revealsAnsweredQuestions {
+				reviewedQuestion.IsCorrect = selectedAnswer.IsCorrect
+			}
+		}
+
+		ar.Questions = append(ar.Questions, reviewedQuestion)
+	}
+
+	if !revealsAnsweredQuestions {
+		for i := range ar.Timeline {
+			ar.Timeline[i].IsCorrect = false
+		}
+	}
+
+	return ar, nil
+}
@@ -0,0 +1,153 @@
+package attemptreview
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"slices"
+	"testing"
+	"time"
+)
+
+var quiz = course.StepQuiz{
+	ID: "quiz",
+	Questions: []course.QuizQuestion{
+		{ID: "a", Answers: []course.QuizAnswer{{ID: "a-1", IsCorrect: true}, {ID: "a-2"}}},
+		{ID: "b", Answers: []course.QuizAnswer{{ID: "b-1", IsCorrect: true}, {ID: "b-2"}}},
+	},
+}
+
+func TestNewAttemptReview_OngoingAttempt_RevealsOnlyAnsweredQuestions(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a", "b"}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, "a", "a-2", false))
+
+	review := err.PanicIfError1(NewAttemptReview(p, quiz, 1))
+
+	if review.Finished {
+		t.Fatalf("expected the ongoing attempt not to be finished")
+	}
+
+	answered := findQuestion(review, "a")
+	if !answered.Revealed || answered.SelectedAnswerID != "a-2" || answered.IsCorrect {
+		t.Fatalf("expected the answered question to be revealed as wrong, got %v", answered)
+	}
+
+	if findQuestion(review, "b").Revealed {
+		t.Fatalf("expected the unanswered question to stay hidden")
+	}
+}
+
+func TestNewAttemptReview_OngoingAttemptWithChangeableAnswers_HidesAnswerKey(t *testing.T) {
+	examQuiz := quiz
+	examQuiz.FeedbackDisabled = true
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, []string{"a", "b"}, participant.AttemptSettings{Quiz: examQuiz}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, "a", "a-1", true))
+
+	review := err.PanicIfError1(NewAttemptReview(p, examQuiz, 1))
+
+	answered := findQuestion(review, "a")
+	if answered.Revealed || answered.IsCorrect || review.Timeline[0].IsCorrect {
+		t.Fatalf("expected the answer key to stay hidden while the answer can be changed, got %v", review)
+	}
+}
+
+func TestNewAttemptReview_OngoingAttemptOfQuizWithDisabledFeedback_HidesAnswerKey(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(quiz.ID, []string{"a", "b"}, participant.AttemptSettings{Quiz: quiz}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, "a", "a-1", true))
+
+	examQuiz := quiz
+	examQuiz.FeedbackDisabled = true
+	review := err.PanicIfError1(NewAttemptReview(p, examQuiz, 1))
+
+	if findQuestion(review, "a").Revealed {
+		t.Fatalf("expected no answer key during an attempt of a quiz without feedback")
+	}
+}
+
+func TestNewAttemptReview_FinishedAttempt_RevealsAllQuestions(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a"}))
+	err.PanicIfError(p.SelectQuizAnswer(quiz.ID, "a", "a-1", true))
+	err.PanicIfError(p.FinishQuiz(quiz.ID))
+
+	review := err.PanicIfError1(NewAttemptReview(p, quiz, 1))
+
+	if !review.Finished || !findQuestion(review, "b").Revealed {
+		t.Fatalf("expected every question of the finished attempt to be revealed, got %v", review)
+	}
+}
+
+func TestNewAttemptReview_ChangedAnswers_AreInTimelineWithTimeSpent(t *testing.T) {
+	examQuiz := quiz
+	examQuiz.FeedbackDisabled = true
+
+	p := participantbuilder.New(time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)).
+		StartQuizWithSettings(quiz.ID, []string{"a", "b"}, participant.AttemptSettings{Quiz: examQuiz}).
+		After(30*time.Second).SelectAnswer(quiz.ID, "a", "a-2", false).
+		After(20*time.Second).SelectAnswer(quiz.ID, "b", "b-1", true).
+		After(10*time.Second).SelectAnswer(quiz.ID, "a", "a-1", true).
+		After(10 * time.Second).FinishQuiz(quiz.ID).
+		Build()
+
+	review := err.PanicIfError1(NewAttemptReview(p, quiz, 1))
+
+	if len(review.Timeline) != 3 || review.Timeline[0].AnswerID != "a-2" || review.Timeline[2].AnswerID != "a-1" {
+		t.Fatalf("expected every selection in chronological order, got %v", review.Timeline)
+	}
+
+	if review.Timeline[1].SecsSincePrevious != 20 {
+		t.Fatalf("expected 20 seconds since the previous selection, got %d", review.Timeline[1].SecsSincePrevious)
+	}
+
+	changed := findQuestion(review, "a")
+	if changed.SelectedAnswerID != "a-1" || !changed.IsCorrect || !slices.Equal(changed.SelectedAnswerIDs, []string{"a-2", "a-1"}) {
+		t.Fatalf("expected the final correct answer and both chosen answers, got %v", changed)
+	}
+
+	if changed.TimeSpentSecs != 40 {
+		t.Fatalf("expected 40 seconds spent on the changed question, got %d", changed.TimeSpentSecs)
+	}
+}
+
+func TestNewAttemptReview_UsedHints_AreListedPerQuestion(t *testing.T) {
+	quizWithHints := quiz
+	quizWithHints.Questions = []course.QuizQuestion{quiz.Questions[0], quiz.Questions[1]}
+	quizWithHints.Questions[0].Hints = []string{"first hint", "second hint"}
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quiz.ID, []string{"a", "b"}))
+	err.PanicIfError1(p.RequestHint(quizWithHints, "a"))
+
+	review := err.PanicIfError1(NewAttemptReview(p, quizWithHints, 1))
+
+	if !slices.Equal(findQuestion(review, "a").UsedHints, []string{"first hint"}) || len(findQuestion(review, "b").UsedHints) != 0 {
+		t.Fatalf("expected only the first hint of question a to be used, got %v", review.Questions)
+	}
+}
+
+func TestNewAttemptReview_UnknownAttempt_ReturnsAttemptNotFoundError(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	_, reviewErr := NewAttemptReview(p, quiz, 1)
+
+	if !errors.As(reviewErr, &quizattemptdetail.AttemptNotFoundError{}) {
+		t.Fatalf("expected attempt not found error, got %v", reviewErr)
+	}
+}
+
+func findQuestion(review AttemptReview, questionID string) ReviewedQuestion {
+	for _, question := range review.Questions {
+		if question.Question.ID == questionID {
+			return question
+		}
+	}
+
+	panic("question " + questionID + " not found in review")
+}
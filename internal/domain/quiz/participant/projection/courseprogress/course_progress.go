@@ -0,0 +1,133 @@
+package courseprogress
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/domain/quiz/participant/projection"
+	"math"
+	"time"
+)
+
+// CourseProgress combines the course structure with the quiz attempts of a participant. A step is completed once
+// every quiz of the step has a passed attempt.
+type CourseProgress struct {
+	CourseID string
+
+	CompletedStepIDs []string
+
+	// CurrentStepID is the first step that is not completed, empty once the course is completed
+	CurrentStepID string
+
+	// NextRecommendedQuizID is the ongoing or first not passed quiz of the current step, empty once the course is completed
+	NextRecommendedQuizID string
+
+	CompletionPercentage int
+
+	// LastActivityAt is the time of the latest event of a quiz of the course, zero without any activity
+	LastActivityAt time.Time
+
+	// CertificateID is the certificate issued once the course is completed, empty before
+	CertificateID string
+}
+
+func NewCourseProgress(p participant.Participant, c course.Course, now time.Time) (CourseProgress, error) {
+	quizOverview, err := projection.NewQuizOverview(p, now)
+	if err != nil {
+		return CourseProgress{}, err
+	}
+
+	cp := CourseProgress{
+		CourseID:         c.ID,
+		CompletedStepIDs: []string{},
+	}
+
+	quizCount := 0
+	passedQuizCount := 0
+
+	for _, step := range c.Steps {
+		isStepCompleted := true
+		nextQuizID := ""
+
+		for _, quiz := range step.Quizzes {
+			quizCount++
+
+			if isQuizPassed(quizOverview, quiz.ID) {
+				passedQuizCount++
+				continue
+			}
+
+			isStepCompleted = false
+
+			isFirstOpenQuiz := nextQuizID == ""
+			isFirstOngoingQuiz := isQuizOngoing(quizOverview, quiz.ID) && !isQuizOngoing(quizOverview, nextQuizID)
+			if isFirstOpenQuiz || isFirstOngoingQuiz {
+				nextQuizID = quiz.ID
+			}
+		}
+
+		if isStepCompleted {
+			cp.CompletedStepIDs = append(cp.CompletedStepIDs, step.ID)
+		} else if cp.CurrentStepID == "" {
+			cp.CurrentStepID = step.ID
+			cp.NextRecommendedQuizID = nextQuizID
+		}
+	}
+
+	if quizCount > 0 {
+		cp.CompletionPercentage = int(math.Floor(float64(passedQuizCount) / float64(quizCount) * 100))
+	}
+
+	cp.LastActivityAt = findLastActivity(p, c)
+
+	certificate, ok := p.GetCourseCertificate(c.ID)
+	if ok {
+		cp.CertificateID = certificate.ID
+	}
+
+	return cp, nil
+}
+
+func isQuizPassed(quizOverview projection.QuizOverview, quizID string) bool {
+	for _, finishedAttempt := range quizOverview.FinishedQuizzes[quizID] {
+		if finishedAttempt.Graded && finishedAttempt.Pass {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isQuizOngoing(quizOverview projection.QuizOverview, quizID string) bool {
+	return len(quizOverview.ActiveQuizzes[quizID]) > 0
+}
+
+func findLastActivity(p participant.Participant, c course.Course) time.Time {
+	lastActivityAt := time.Time{}
+
+	for _, generalEvent := range p.GetEvents() {
+		var quizID string
+
+		switch e := generalEvent.(type) {
+		case event.StartedQuiz:
+			quizID = e.QuizID
+		case event.SelectedAnswer:
+			quizID = e.QuizID
+		case event.FinishedQuiz:
+			quizID = e.QuizID
+		case event.QuizTimedOut:
+			quizID = e.QuizID
+		case event.ReviewedQuestion:
+			quizID = e.QuizID
+		default:
+			continue
+		}
+
+		_, isCourseQuiz := c.FindQuiz(quizID)
+		if isCourseQuiz && generalEvent.GetCreatedAt().After(lastActivityAt) {
+			lastActivityAt = generalEvent.GetCreatedAt()
+		}
+	}
+
+	return lastActivityAt
+}
@@ -0,0 +1,126 @@
+package courseprogress_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/courseprogress"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"testing"
+	"time"
+)
+
+var testCourse = course.Course{
+	ID: "course",
+	Steps: []course.Step{
+		{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz-1"}}},
+		{ID: "step-2", Quizzes: []course.StepQuiz{{ID: "quiz-2"}, {ID: "quiz-3"}}},
+		{ID: "step-3", Quizzes: []course.StepQuiz{{ID: "quiz-4"}}},
+	},
+}
+
+func TestNewCourseProgress_WithoutActivity_StartsAtFirstStep(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+
+	cp := err.PanicIfError1(courseprogress.NewCourseProgress(p, testCourse, time.Now()))
+
+	if cp.CurrentStepID != "step-1" || cp.NextRecommendedQuizID != "quiz-1" {
+		t.Fatalf("expected first step and quiz, got %s and %s", cp.CurrentStepID, cp.NextRecommendedQuizID)
+	}
+
+	if cp.CompletionPercentage != 0 || len(cp.CompletedStepIDs) != 0 || !cp.LastActivityAt.IsZero() {
+		t.Fatalf("expected no progress, got %v", cp)
+	}
+}
+
+func TestNewCourseProgress_PassedQuizCompletesStep(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithAttempts(finishedAt, attempt{quizID: "quiz-1", isCorrect: true, isFinished: true})
+
+	cp := err.PanicIfError1(courseprogress.NewCourseProgress(p, testCourse, time.Now()))
+
+	if len(cp.CompletedStepIDs) != 1 || cp.CompletedStepIDs[0] != "step-1" {
+		t.Fatalf("expected step-1 to be completed, got %v", cp.CompletedStepIDs)
+	}
+
+	if cp.CurrentStepID != "step-2" || cp.NextRecommendedQuizID != "quiz-2" {
+		t.Fatalf("expected step-2 with quiz-2 next, got %s and %s", cp.CurrentStepID, cp.NextRecommendedQuizID)
+	}
+
+	if cp.CompletionPercentage != 25 {
+		t.Fatalf("expected 25 percent completed, got %d", cp.CompletionPercentage)
+	}
+
+	if !cp.LastActivityAt.Equal(finishedAt) {
+		t.Fatalf("expected last activity at %v, got %v", finishedAt, cp.LastActivityAt)
+	}
+}
+
+func TestNewCourseProgress_FailedQuizDoesNotCompleteStep(t *testing.T) {
+	p := createParticipantWithAttempts(time.Now(), attempt{quizID: "quiz-1", isCorrect: false, isFinished: true})
+
+	cp := err.PanicIfError1(courseprogress.NewCourseProgress(p, testCourse, time.Now()))
+
+	if cp.CurrentStepID != "step-1" || cp.CompletionPercentage != 0 {
+		t.Fatalf("expected step-1 to stay current, got %s with %d percent", cp.CurrentStepID, cp.CompletionPercentage)
+	}
+}
+
+func TestNewCourseProgress_RecommendsOngoingQuizOfCurrentStep(t *testing.T) {
+	p := createParticipantWithAttempts(time.Now(),
+		attempt{quizID: "quiz-1", isCorrect: true, isFinished: true},
+		attempt{quizID: "quiz-3", isCorrect: true, isFinished: false},
+	)
+
+	cp := err.PanicIfError1(courseprogress.NewCourseProgress(p, testCourse, time.Now()))
+
+	if cp.NextRecommendedQuizID != "quiz-3" {
+		t.Fatalf("expected the ongoing quiz-3 to be recommended, got %s", cp.NextRecommendedQuizID)
+	}
+}
+
+func TestNewCourseProgress_ContainsCertificateOfCompletedCourse(t *testing.T) {
+	p := createParticipantWithAttempts(time.Now(),
+		attempt{quizID: "quiz-1", isCorrect: true, isFinished: true},
+		attempt{quizID: "quiz-2", isCorrect: true, isFinished: true},
+		attempt{quizID: "quiz-3", isCorrect: true, isFinished: true},
+		attempt{quizID: "quiz-4", isCorrect: true, isFinished: true},
+	)
+	err.PanicIfError1(p.CompleteCourse(testCourse, signer{}))
+
+	cp := err.PanicIfError1(courseprogress.NewCourseProgress(p, testCourse, time.Now()))
+
+	if cp.CertificateID == "" || cp.CompletionPercentage != 100 {
+		t.Fatalf("expected a certificate of the completed course, got %v", cp)
+	}
+}
+
+type signer struct {
+}
+
+func (s signer) Sign(_ participant.Certificate) string {
+	return "signature"
+}
+
+type attempt struct {
+	quizID     string
+	isCorrect  bool
+	isFinished bool
+}
+
+func createParticipantWithAttempts(lastActivityAt time.Time, attempts ...attempt) participant.Participant {
+	startedAt := lastActivityAt.Add(-time.Hour)
+	b := participantbuilder.New(startedAt)
+
+	for _, a := range attempts {
+		b.At(startedAt).
+			StartQuiz(a.quizID, "question").
+			SelectAnswer(a.quizID, "question", "answer", a.isCorrect)
+
+		if a.isFinished {
+			b.At(lastActivityAt).FinishQuiz(a.quizID)
+		}
+	}
+
+	return b.Build()
+}
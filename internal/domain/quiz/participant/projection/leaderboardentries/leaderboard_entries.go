@@ -0,0 +1,110 @@
+package leaderboardentries
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"sort"
+	"time"
+)
+
+// NewLeaderboardEntries calculates the entries of the participant in the all-time and in every weekly leaderboard of
+// the courses. Experience points are counted like in the participant stats and a quiz counts as passed once per period,
+// practice attempts and retakes earn no experience points.
+// The entries are calculated from all events, so storing them again is idempotent.
+func NewLeaderboardEntries(p participant.Participant, courses []course.Course) []leaderboard.Entry {
+	var entries []leaderboard.Entry
+
+	for _, c := range courses {
+		entries = append(entries, newCourseEntries(p, c)...)
+	}
+
+	return entries
+}
+
+func newCourseEntries(p participant.Participant, c course.Course) []leaderboard.Entry {
+	entriesByPeriod := map[string]*leaderboard.Entry{}
+	getEntry := func(period string) *leaderboard.Entry {
+		if entriesByPeriod[period] == nil {
+			entriesByPeriod[period] = &leaderboard.Entry{CourseID: c.ID, Period: period, ParticipantID: p.GetID()}
+		}
+
+		return entriesByPeriod[period]
+	}
+
+	addExperiencePoints := func(experiencePoints int, earnedAt time.Time) {
+		getEntry(leaderboard.AllTimePeriod).XP += experiencePoints
+		getEntry(leaderboard.GetWeekPeriod(earnedAt)).XP += experiencePoints
+	}
+
+	questionDifficulties := c.GetQuestionDifficulties()
+	activeAttemptAnswers := map[string]map[string]bool{}
+	ungradedQuizIDs := map[string]bool{}
+
+	for _, generalEvent := range p.GetRegradedEvents() {
+		switch e := generalEvent.(type) {
+		case event.StartedQuiz:
+			activeAttemptAnswers[e.QuizID] = map[string]bool{}
+			ungradedQuizIDs[e.QuizID] = !e.IsGraded()
+		case event.SelectedAnswer:
+			if activeAttemptAnswers[e.QuizID] == nil {
+				activeAttemptAnswers[e.QuizID] = map[string]bool{}
+			}
+			activeAttemptAnswers[e.QuizID][e.QuestionID] = e.IsCorrect
+		case event.FinishedQuiz:
+			if _, ok := c.FindQuiz(e.QuizID); ok && !ungradedQuizIDs[e.QuizID] {
+				addExperiencePoints(getAttemptExperiencePoints(activeAttemptAnswers[e.QuizID], questionDifficulties), e.CreatedAt)
+			}
+			delete(activeAttemptAnswers, e.QuizID)
+		case event.QuizTimedOut:
+			if _, ok := c.FindQuiz(e.QuizID); ok && !ungradedQuizIDs[e.QuizID] {
+				addExperiencePoints(getAttemptExperiencePoints(activeAttemptAnswers[e.QuizID], questionDifficulties), e.CreatedAt)
+			}
+			delete(activeAttemptAnswers, e.QuizID)
+		case event.ReviewedQuestion:
+			if _, ok := c.FindQuiz(e.QuizID); ok && e.IsCorrect {
+				addExperiencePoints(calculator.ExperiencePointsPerCorrectReview, e.CreatedAt)
+			}
+		}
+	}
+
+	passedQuizIDsByPeriod := map[string]map[string]bool{}
+	for _, finishedAttempt := range p.GetFinishedAttempts(c) {
+		if !finishedAttempt.Pass {
+			continue
+		}
+
+		for _, period := range []string{leaderboard.AllTimePeriod, leaderboard.GetWeekPeriod(finishedAttempt.FinishedAt)} {
+			if passedQuizIDsByPeriod[period] == nil {
+				passedQuizIDsByPeriod[period] = map[string]bool{}
+			}
+
+			if !passedQuizIDsByPeriod[period][finishedAttempt.QuizID] {
+				passedQuizIDsByPeriod[period][finishedAttempt.QuizID] = true
+				getEntry(period).QuizzesPassed++
+			}
+		}
+	}
+
+	entries := make([]leaderboard.Entry, 0, len(entriesByPeriod))
+	for _, entry := range entriesByPeriod {
+		entries = append(entries, *entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Period < entries[j].Period
+	})
+
+	return entries
+}
+
+func getAttemptExperiencePoints(answers map[string]bool, questionDifficulties map[string]string) int {
+	experiencePoints := 0
+	for questionID, isCorrect := range answers {
+		experiencePoints += calculator.GetExperiencePoints(questionDifficulties[questionID], isCorrect)
+	}
+
+	return experiencePoints
+}
@@ -0,0 +1,135 @@
+package leaderboardentries_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/leaderboardentries"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"testing"
+	"time"
+)
+
+var testCourse = course.Course{
+	ID: "course",
+	Steps: []course.Step{
+		{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz", Questions: []course.QuizQuestion{
+			{ID: "easy-question", Difficulty: "easy"},
+			{ID: "hard-question", Difficulty: "hard"},
+		}}}},
+	},
+}
+
+var firstWeek = time.Date(2023, 11, 8, 12, 0, 0, 0, time.UTC)
+var secondWeek = firstWeek.AddDate(0, 0, 7)
+
+func TestNewLeaderboardEntries_ScoresAllTimeAndWeeks(t *testing.T) {
+	p := createParticipant(
+		attempt{finishedAt: firstWeek, easyCorrect: true, hardCorrect: true},
+		attempt{finishedAt: secondWeek, easyCorrect: false, hardCorrect: false},
+	)
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, []course.Course{testCourse})
+
+	expected := []leaderboard.Entry{
+		{CourseID: "course", Period: "2023-W45", ParticipantID: participantbuilder.ParticipantID, XP: 30, QuizzesPassed: 1},
+		{CourseID: "course", Period: "2023-W46", ParticipantID: participantbuilder.ParticipantID, XP: 4, QuizzesPassed: 0},
+		{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: participantbuilder.ParticipantID, XP: 34, QuizzesPassed: 1},
+	}
+
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %v", len(expected), entries)
+	}
+
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Fatalf("expected %v, got %v", expected[i], entry)
+		}
+	}
+}
+
+func TestNewLeaderboardEntries_CountsPassedQuizOncePerPeriod(t *testing.T) {
+	p := createParticipant(
+		attempt{finishedAt: firstWeek, easyCorrect: true, hardCorrect: true},
+		attempt{finishedAt: firstWeek.Add(time.Hour), easyCorrect: true, hardCorrect: true},
+		attempt{finishedAt: secondWeek, easyCorrect: true, hardCorrect: true},
+	)
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, []course.Course{testCourse})
+
+	for _, entry := range entries {
+		if entry.QuizzesPassed != 1 {
+			t.Fatalf("expected the quiz to be passed once in period %v, got %d", entry.Period, entry.QuizzesPassed)
+		}
+	}
+}
+
+func TestNewLeaderboardEntries_IgnoresQuizzesOfOtherCourses(t *testing.T) {
+	p := createParticipant(attempt{finishedAt: firstWeek, easyCorrect: true, hardCorrect: true})
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, []course.Course{{ID: "other-course"}})
+
+	if len(entries) != 0 {
+		t.Fatalf("expected no entries, got %v", entries)
+	}
+}
+
+func TestNewLeaderboardEntries_IgnoresPracticeAttempts(t *testing.T) {
+	p := createParticipant(attempt{finishedAt: firstWeek, easyCorrect: true, hardCorrect: true, practice: true})
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, []course.Course{testCourse})
+
+	if len(entries) != 0 {
+		t.Fatalf("expected no entries, got %v", entries)
+	}
+}
+
+func TestNewLeaderboardEntries_RegradedAnswer_ScoresRegradedCorrectness(t *testing.T) {
+	p := createParticipant(attempt{finishedAt: firstWeek, easyCorrect: false, hardCorrect: true})
+
+	correctedCourse := course.Course{
+		ID: "course",
+		Steps: []course.Step{
+			{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz", Questions: []course.QuizQuestion{
+				{ID: "easy-question", Difficulty: "easy", Answers: []course.QuizAnswer{{ID: "answer", IsCorrect: true}}},
+				{ID: "hard-question", Difficulty: "hard", Answers: []course.QuizAnswer{{ID: "answer", IsCorrect: true}}},
+			}}}},
+		},
+	}
+	err.PanicIfError1(p.RegradeAnswers(correctedCourse))
+
+	entries := leaderboardentries.NewLeaderboardEntries(p, []course.Course{testCourse})
+
+	for _, entry := range entries {
+		if entry.XP != 30 || entry.QuizzesPassed != 1 {
+			t.Fatalf("expected the regraded attempt to score like a correct one in period %v, got %v", entry.Period, entry)
+		}
+	}
+}
+
+type attempt struct {
+	finishedAt  time.Time
+	easyCorrect bool
+	hardCorrect bool
+	practice    bool
+}
+
+func createParticipant(attempts ...attempt) participant.Participant {
+	b := participantbuilder.New(firstWeek.AddDate(0, 0, -10))
+
+	for _, a := range attempts {
+		settings := participant.AttemptSettings{}
+		if a.practice {
+			settings.Mode = participant.AttemptModePractice
+		}
+
+		b.At(a.finishedAt).
+			StartQuizWithSettings("quiz", nil, settings).
+			SelectAnswer("quiz", "easy-question", "answer", a.easyCorrect).
+			SelectAnswer("quiz", "hard-question", "answer", a.hardCorrect).
+			FinishQuiz("quiz")
+	}
+
+	return b.Build()
+}
@@ -0,0 +1,167 @@
+package participantstats
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"time"
+)
+
+// ParticipantStats summarizes the learning activity of a participant. Calendar days are counted in the timezone of
+// the participant and only event timestamps are used, so the stats only depend on the events and the given time.
+type ParticipantStats struct {
+	Timezone string
+
+	// TotalXP sums the experience points of the final answers of finished quiz attempts and correct reviews
+	TotalXP int
+
+	// XPToday are the experience points earned on the current calendar day
+	XPToday int
+
+	CurrentStreakDays   int
+	LongestStreakDays   int
+	AvailableFreezeDays int
+
+	// LastActiveDay is the latest calendar day with activity formatted as 2006-01-02, empty without activity
+	LastActiveDay string
+
+	// Calibration compares the confidence with the accuracy of the final answers of all finished quiz attempts
+	Calibration calculator.Calibration
+
+	// WeeklyCalibrations show the calibration over time, ordered by week and only weeks with finished attempts
+	WeeklyCalibrations []WeeklyCalibration
+}
+
+type WeeklyCalibration struct {
+	// Week is the ISO week in the timezone of the participant formatted as 2006-W01
+	Week        string
+	Calibration calculator.Calibration
+}
+
+// NewParticipantStats calculates the stats, the courses provide the difficulty of the answered questions.
+func NewParticipantStats(p participant.Participant, courses []course.Course, now time.Time) ParticipantStats {
+	location := p.GetLocation()
+	today := now.In(location)
+
+	stats := ParticipantStats{
+		Timezone:           p.GetTimezone(),
+		Calibration:        calculator.NewCalibration(),
+		WeeklyCalibrations: []WeeklyCalibration{},
+	}
+
+	questionDifficulties := getQuestionDifficulties(courses)
+	activeAttemptAnswers := map[string]map[string]bool{}
+	activeAttemptConfidences := map[string]map[string]string{}
+	ungradedQuizIDs := map[string]bool{}
+
+	var activeDays []time.Time
+
+	for _, generalEvent := range p.GetRegradedEvents() {
+		experiencePoints := 0
+
+		switch e := generalEvent.(type) {
+		case event.StartedQuiz:
+			activeAttemptAnswers[e.QuizID] = map[string]bool{}
+			activeAttemptConfidences[e.QuizID] = map[string]string{}
+			ungradedQuizIDs[e.QuizID] = !e.IsGraded()
+		case event.SelectedAnswer:
+			if activeAttemptAnswers[e.QuizID] == nil {
+				activeAttemptAnswers[e.QuizID] = map[string]bool{}
+				activeAttemptConfidences[e.QuizID] = map[string]string{}
+			}
+			activeAttemptAnswers[e.QuizID][e.QuestionID] = e.IsCorrect
+			activeAttemptConfidences[e.QuizID][e.QuestionID] = e.Confidence
+		case event.FinishedQuiz:
+			// practice attempts and retakes can be repeated at will, they earn no XP
+			if !ungradedQuizIDs[e.QuizID] {
+				experiencePoints = getAttemptExperiencePoints(activeAttemptAnswers[e.QuizID], questionDifficulties)
+			}
+			stats.addCalibration(activeAttemptAnswers[e.QuizID], activeAttemptConfidences[e.QuizID], e.CreatedAt.In(location))
+			delete(activeAttemptAnswers, e.QuizID)
+			delete(activeAttemptConfidences, e.QuizID)
+		case event.QuizTimedOut:
+			if !ungradedQuizIDs[e.QuizID] {
+				experiencePoints = getAttemptExperiencePoints(activeAttemptAnswers[e.QuizID], questionDifficulties)
+			}
+			stats.addCalibration(activeAttemptAnswers[e.QuizID], activeAttemptConfidences[e.QuizID], e.CreatedAt.In(location))
+			delete(activeAttemptAnswers, e.QuizID)
+			delete(activeAttemptConfidences, e.QuizID)
+
+			// a time out is detected by the system and is no activity of the participant
+			stats.addExperiencePoints(experiencePoints, e.CreatedAt.In(location), today)
+			continue
+		case event.ReviewedQuestion:
+			if e.IsCorrect {
+				experiencePoints = calculator.ExperiencePointsPerCorrectReview
+			}
+		default:
+			continue
+		}
+
+		activeDay := generalEvent.GetCreatedAt().In(location)
+		activeDays = append(activeDays, activeDay)
+		stats.addExperiencePoints(experiencePoints, activeDay, today)
+
+		lastActiveDay := activeDay.Format(time.DateOnly)
+		if lastActiveDay > stats.LastActiveDay {
+			stats.LastActiveDay = lastActiveDay
+		}
+	}
+
+	streak := calculator.NewStreak(activeDays, today)
+	stats.CurrentStreakDays = streak.CurrentDays
+	stats.LongestStreakDays = streak.LongestDays
+	stats.AvailableFreezeDays = streak.AvailableFreezeDays
+
+	return stats
+}
+
+func (s *ParticipantStats) addExperiencePoints(experiencePoints int, earnedAt time.Time, today time.Time) {
+	s.TotalXP += experiencePoints
+
+	if earnedAt.Format(time.DateOnly) == today.Format(time.DateOnly) {
+		s.XPToday += experiencePoints
+	}
+}
+
+// addCalibration adds the final answers of an attempt to the overall calibration and to the one of the week the
+// attempt ended in.
+func (s *ParticipantStats) addCalibration(answers map[string]bool, confidences map[string]string, endedAt time.Time) {
+	year, week := endedAt.ISOWeek()
+	weekPeriod := fmt.Sprintf("%d-W%02d", year, week)
+
+	if len(s.WeeklyCalibrations) == 0 || s.WeeklyCalibrations[len(s.WeeklyCalibrations)-1].Week != weekPeriod {
+		s.WeeklyCalibrations = append(s.WeeklyCalibrations, WeeklyCalibration{
+			Week:        weekPeriod,
+			Calibration: calculator.NewCalibration(),
+		})
+	}
+	weeklyCalibration := &s.WeeklyCalibrations[len(s.WeeklyCalibrations)-1].Calibration
+
+	for questionID, isCorrect := range answers {
+		s.Calibration.AddAnswer(confidences[questionID], isCorrect)
+		weeklyCalibration.AddAnswer(confidences[questionID], isCorrect)
+	}
+}
+
+func getAttemptExperiencePoints(answers map[string]bool, questionDifficulties map[string]string) int {
+	experiencePoints := 0
+	for questionID, isCorrect := range answers {
+		experiencePoints += calculator.GetExperiencePoints(questionDifficulties[questionID], isCorrect)
+	}
+
+	return experiencePoints
+}
+
+func getQuestionDifficulties(courses []course.Course) map[string]string {
+	questionDifficulties := map[string]string{}
+	for _, c := range courses {
+		for questionID, difficulty := range c.GetQuestionDifficulties() {
+			questionDifficulties[questionID] = difficulty
+		}
+	}
+
+	return questionDifficulties
+}
@@ -0,0 +1,160 @@
+package participantstats_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/participantstats"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"testing"
+	"time"
+)
+
+var testCourse = course.Course{
+	ID: "course",
+	Steps: []course.Step{
+		{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz", Questions: []course.QuizQuestion{
+			{ID: "easy-question", Difficulty: "easy"},
+			{ID: "hard-question", Difficulty: "hard"},
+		}}}},
+	},
+}
+
+var firstDay = time.Date(2023, 11, 6, 12, 0, 0, 0, time.UTC)
+
+func TestNewParticipantStats_WeightsXPByDifficultyAndCorrectness(t *testing.T) {
+	p := createParticipantWithQuizzesOnDays("", firstDay)
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay)
+
+	// correct hard question 20 XP, wrong easy question 2 XP
+	if stats.TotalXP != 22 || stats.XPToday != 22 {
+		t.Fatalf("expected 22 XP in total and today, got %d and %d", stats.TotalXP, stats.XPToday)
+	}
+}
+
+func TestNewParticipantStats_RegradedAnswer_AwardsXPOfRegradedCorrectness(t *testing.T) {
+	p := createParticipantWithQuizzesOnDays("", firstDay)
+	correctedCourse := course.Course{
+		ID: "course",
+		Steps: []course.Step{
+			{ID: "step-1", Quizzes: []course.StepQuiz{{ID: "quiz", Questions: []course.QuizQuestion{
+				{ID: "easy-question", Difficulty: "easy", Answers: []course.QuizAnswer{{ID: "answer", IsCorrect: true}}},
+				{ID: "hard-question", Difficulty: "hard", Answers: []course.QuizAnswer{{ID: "answer", IsCorrect: true}}},
+			}}}},
+		},
+	}
+	err.PanicIfError1(p.RegradeAnswers(correctedCourse))
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay)
+
+	// correct hard question 20 XP, regraded easy question 10 XP
+	if stats.TotalXP != 30 || stats.Calibration.Levels[0].GetAccuracy() != 1 {
+		t.Fatalf("expected 30 XP and accurate low confidence after the regrade, got %d and %+v", stats.TotalXP, stats.Calibration)
+	}
+}
+
+func TestNewParticipantStats_PracticeAttempt_EarnsNoXP(t *testing.T) {
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings("quiz", nil, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer("quiz", "hard-question", "answer", true))
+	err.PanicIfError(p.FinishQuiz("quiz"))
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, time.Now())
+
+	if stats.TotalXP != 0 {
+		t.Fatalf("expected no XP for a practice attempt, got %d", stats.TotalXP)
+	}
+}
+
+func TestNewParticipantStats_CountsConsecutiveDays(t *testing.T) {
+	p := createParticipantWithQuizzesOnDays("", firstDay, firstDay.AddDate(0, 0, 1), firstDay.AddDate(0, 0, 2))
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay.AddDate(0, 0, 3))
+
+	if stats.CurrentStreakDays != 3 || stats.LongestStreakDays != 3 {
+		t.Fatalf("expected a streak of 3 days, got %d current and %d longest", stats.CurrentStreakDays, stats.LongestStreakDays)
+	}
+
+	if stats.XPToday != 0 || stats.LastActiveDay != "2023-11-08" {
+		t.Fatalf("expected no XP today and last activity on 2023-11-08, got %d and %s", stats.XPToday, stats.LastActiveDay)
+	}
+}
+
+func TestNewParticipantStats_LosesStreakAfterMissedDayWithoutFreezeDays(t *testing.T) {
+	p := createParticipantWithQuizzesOnDays("", firstDay, firstDay.AddDate(0, 0, 1), firstDay.AddDate(0, 0, 3))
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay.AddDate(0, 0, 3))
+
+	if stats.CurrentStreakDays != 1 || stats.LongestStreakDays != 2 {
+		t.Fatalf("expected the streak to restart, got %d current and %d longest", stats.CurrentStreakDays, stats.LongestStreakDays)
+	}
+}
+
+func TestNewParticipantStats_FreezeDayKeepsStreak(t *testing.T) {
+	var activeDays []time.Time
+	for day := 0; day < 7; day++ {
+		activeDays = append(activeDays, firstDay.AddDate(0, 0, day))
+	}
+	activeDays = append(activeDays, firstDay.AddDate(0, 0, 8))
+
+	p := createParticipantWithQuizzesOnDays("", activeDays...)
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay.AddDate(0, 0, 8))
+
+	if stats.CurrentStreakDays != 8 || stats.AvailableFreezeDays != 0 {
+		t.Fatalf("expected the earned freeze day to keep the streak of 8 days, got %d with %d freeze days left", stats.CurrentStreakDays, stats.AvailableFreezeDays)
+	}
+}
+
+func TestNewParticipantStats_CountsDaysInTimezoneOfParticipant(t *testing.T) {
+	lateEvening := time.Date(2023, 11, 6, 23, 30, 0, 0, time.UTC)
+	nextEvening := lateEvening.AddDate(0, 0, 1)
+
+	utcParticipant := createParticipantWithQuizzesOnDays("", lateEvening, nextEvening)
+	berlinParticipant := createParticipantWithQuizzesOnDays("Europe/Berlin", lateEvening, nextEvening)
+
+	utcStats := participantstats.NewParticipantStats(utcParticipant, []course.Course{testCourse}, nextEvening)
+	berlinStats := participantstats.NewParticipantStats(berlinParticipant, []course.Course{testCourse}, nextEvening)
+
+	if utcStats.LastActiveDay != "2023-11-07" || berlinStats.LastActiveDay != "2023-11-08" {
+		t.Fatalf("expected the last active day in the timezone, got %s in UTC and %s in Berlin", utcStats.LastActiveDay, berlinStats.LastActiveDay)
+	}
+
+	if berlinStats.Timezone != "Europe/Berlin" || berlinStats.CurrentStreakDays != 2 {
+		t.Fatalf("expected a streak of 2 days in Europe/Berlin, got %d in %s", berlinStats.CurrentStreakDays, berlinStats.Timezone)
+	}
+}
+
+func TestNewParticipantStats_CalibratesConfidencePerWeek(t *testing.T) {
+	p := createParticipantWithQuizzesOnDays("", firstDay, firstDay.AddDate(0, 0, 1), firstDay.AddDate(0, 0, 7))
+
+	stats := participantstats.NewParticipantStats(p, []course.Course{testCourse}, firstDay.AddDate(0, 0, 7))
+
+	// every attempt answers the easy question wrong with low and the hard question right with high confidence
+	if stats.Calibration.CertaintyScore != 9 || stats.Calibration.Levels[2].GetAccuracy() != 1 || stats.Calibration.Levels[0].GetAccuracy() != 0 {
+		t.Fatalf("expected a certainty score of 9 with accurate high confidence, got %+v", stats.Calibration)
+	}
+
+	if len(stats.WeeklyCalibrations) != 2 || stats.WeeklyCalibrations[0].Week != "2023-W45" || stats.WeeklyCalibrations[0].Calibration.Levels[2].Answers != 2 {
+		t.Fatalf("expected two answers with high confidence in 2023-W45 and a second week, got %+v", stats.WeeklyCalibrations)
+	}
+}
+
+func createParticipantWithQuizzesOnDays(timezone string, days ...time.Time) participant.Participant {
+	b := participantbuilder.New(firstDay.AddDate(0, 0, -10))
+
+	if timezone != "" {
+		b.SetTimezone(timezone)
+	}
+
+	for _, day := range days {
+		b.At(day).
+			StartQuiz("quiz").
+			SelectAnswerWithConfidence("quiz", "easy-question", "answer", false, "low").
+			SelectAnswerWithConfidence("quiz", "hard-question", "answer", true, "high").
+			FinishQuiz("quiz")
+	}
+
+	return b.Build()
+}
@@ -0,0 +1,166 @@
+package questiontime
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"time"
+)
+
+// QuestionTime is the time a participant spent on a question in a single attempt.
+type QuestionTime struct {
+	QuizID string
+
+	// AttemptID is created at runtime and starts at 1 per quiz
+	AttemptID int
+
+	QuestionID    string
+	TimeSpentSecs int
+
+	// Answered tells if the question has an answer, IsCorrect is the regraded correctness of the final answer
+	Answered  bool
+	IsCorrect bool
+
+	// Ended tells if the attempt is finished or timed out, the times of ongoing attempts still change
+	Ended bool
+
+	// EndedAt is the end of the attempt, the zero time for ongoing attempts
+	EndedAt time.Time
+
+	// ChangedVersion is the version of the latest event that ended the attempt or regraded its answers
+	ChangedVersion uint
+}
+
+type attemptTimes struct {
+	startedQuiz    event.StartedQuiz
+	attemptID      int
+	timer          *calculator.QuestionTimer
+	questionIDs    []string
+	finalAnswers   map[string]event.SelectedAnswer
+	ended          bool
+	endedAt        time.Time
+	changedVersion uint
+}
+
+// NewQuestionTimes measures the time spent on the questions of every attempt from the viewed questions and the other
+// activities of the attempts, see calculator.QuestionTimer. The times are ordered by the start of the attempt and the
+// first activity on the question within the attempt.
+func NewQuestionTimes(p participant.Participant) []QuestionTime {
+	var attempts []*attemptTimes
+	latestAttempts := map[string]*attemptTimes{}
+
+	for _, generalEvent := range p.GetRegradedEvents() {
+
+		switch e := generalEvent.(type) {
+
+		case event.StartedQuiz:
+			attemptID := 1
+			if latestAttempt, ok := latestAttempts[e.QuizID]; ok {
+				attemptID = latestAttempt.attemptID + 1
+			}
+
+			attempt := &attemptTimes{
+				startedQuiz:  e,
+				attemptID:    attemptID,
+				timer:        calculator.NewQuestionTimer(e.CreatedAt),
+				finalAnswers: map[string]event.SelectedAnswer{},
+			}
+			attempts = append(attempts, attempt)
+			latestAttempts[e.QuizID] = attempt
+
+		case event.QuestionViewed:
+			if attempt, ok := latestAttempts[e.QuizID]; ok && !attempt.ended {
+				attempt.addQuestion(e.QuestionID)
+				attempt.timer.View(e.QuestionID, e.CreatedAt)
+			}
+
+		case event.SelectedAnswer:
+			if attempt, ok := latestAttempts[e.QuizID]; ok && !attempt.ended {
+				attempt.addQuestion(e.QuestionID)
+				attempt.timer.Activity(e.QuestionID, e.CreatedAt)
+				attempt.finalAnswers[e.QuestionID] = e
+			}
+
+		case event.HintRequested:
+			if attempt, ok := latestAttempts[e.QuizID]; ok && !attempt.ended {
+				attempt.addQuestion(e.QuestionID)
+				attempt.timer.Activity(e.QuestionID, e.CreatedAt)
+			}
+
+		case event.FinishedQuiz:
+			if attempt, ok := latestAttempts[e.QuizID]; ok && !attempt.ended {
+				attempt.end(e.CreatedAt)
+				attempt.changedVersion = e.GetVersion()
+			}
+
+		case event.QuizTimedOut:
+			if attempt, ok := latestAttempts[e.QuizID]; ok && !attempt.ended {
+				// the time out is detected lazily, the attempt ended at its deadline
+				endedAt := e.CreatedAt
+				if attempt.startedQuiz.IsTimed() && attempt.startedQuiz.GetDeadline().Before(endedAt) {
+					endedAt = attempt.startedQuiz.GetDeadline()
+				}
+				attempt.end(endedAt)
+				attempt.changedVersion = e.GetVersion()
+			}
+
+		case event.AnswerRegraded:
+			for _, attempt := range attempts {
+				if attempt.startedQuiz.QuizID == e.QuizID && attempt.attemptID == e.AttemptID {
+					attempt.changedVersion = e.GetVersion()
+				}
+			}
+		}
+	}
+
+	var questionTimes []QuestionTime
+	for _, attempt := range attempts {
+		timeSpentSecs := attempt.timer.GetTimeSpentSecs()
+
+		for _, questionID := range attempt.questionIDs {
+			finalAnswer, answered := attempt.finalAnswers[questionID]
+
+			questionTimes = append(questionTimes, QuestionTime{
+				QuizID:         attempt.startedQuiz.QuizID,
+				AttemptID:      attempt.attemptID,
+				QuestionID:     questionID,
+				TimeSpentSecs:  timeSpentSecs[questionID],
+				Answered:       answered,
+				IsCorrect:      answered && finalAnswer.IsCorrect,
+				Ended:          attempt.ended,
+				EndedAt:        attempt.endedAt,
+				ChangedVersion: attempt.changedVersion,
+			})
+		}
+	}
+
+	return questionTimes
+}
+
+// GetAttemptTimeSpentSecs returns the seconds spent per question of the attempt.
+func GetAttemptTimeSpentSecs(questionTimes []QuestionTime, quizID string, attemptID int) map[string]int {
+	timeSpentSecs := map[string]int{}
+	for _, questionTime := range questionTimes {
+		if questionTime.QuizID == quizID && questionTime.AttemptID == attemptID {
+			timeSpentSecs[questionTime.QuestionID] = questionTime.TimeSpentSecs
+		}
+	}
+
+	return timeSpentSecs
+}
+
+func (a *attemptTimes) addQuestion(questionID string) {
+	for _, knownQuestionID := range a.questionIDs {
+		if knownQuestionID == questionID {
+			return
+		}
+	}
+
+	a.questionIDs = append(a.questionIDs, questionID)
+}
+
+func (a *attemptTimes) end(endedAt time.Time) {
+	a.timer.Stop(endedAt)
+	a.ended = true
+	a.endedAt = endedAt
+}
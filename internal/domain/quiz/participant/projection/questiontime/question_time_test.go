@@ -0,0 +1,84 @@
+package questiontime_test
+
+import (
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/domain/quiz/participant/projection/questiontime"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"testing"
+	"time"
+)
+
+var startedAt = time.Date(2023, 11, 6, 12, 0, 0, 0, time.UTC)
+
+func TestNewQuestionTimes_SpendsTimeOnViewedQuestion(t *testing.T) {
+	p := participantbuilder.New(startedAt).
+		StartQuiz("quiz", "q1", "q2").
+		At(startedAt.Add(5*time.Second)).ViewQuestion("quiz", "q1").
+		At(startedAt.Add(25*time.Second)).ViewQuestion("quiz", "q2").
+		At(startedAt.Add(40*time.Second)).SelectAnswer("quiz", "q2", "a2", true).
+		At(startedAt.Add(50*time.Second)).ViewQuestion("quiz", "q1").
+		At(startedAt.Add(60*time.Second)).SelectAnswer("quiz", "q1", "a1", false).
+		At(startedAt.Add(70 * time.Second)).FinishQuiz("quiz").
+		Build()
+
+	questionTimes := questiontime.NewQuestionTimes(p)
+
+	expectedQuestionTimes := []questiontime.QuestionTime{
+		{QuizID: "quiz", AttemptID: 1, QuestionID: "q1", TimeSpentSecs: 40, Answered: true, IsCorrect: false, Ended: true, EndedAt: startedAt.Add(70 * time.Second), ChangedVersion: 7},
+		{QuizID: "quiz", AttemptID: 1, QuestionID: "q2", TimeSpentSecs: 25, Answered: true, IsCorrect: true, Ended: true, EndedAt: startedAt.Add(70 * time.Second), ChangedVersion: 7},
+	}
+	if len(questionTimes) != 2 || questionTimes[0] != expectedQuestionTimes[0] || questionTimes[1] != expectedQuestionTimes[1] {
+		t.Fatalf("expected %v, got %v", expectedQuestionTimes, questionTimes)
+	}
+}
+
+func TestNewQuestionTimes_CapsIdleGaps(t *testing.T) {
+	p := participantbuilder.New(startedAt).
+		StartQuiz("quiz", "q1").
+		ViewQuestion("quiz", "q1").
+		At(startedAt.Add(2*time.Hour)).SelectAnswer("quiz", "q1", "a1", true).
+		Build()
+
+	questionTimes := questiontime.NewQuestionTimes(p)
+
+	if len(questionTimes) != 1 || questionTimes[0].TimeSpentSecs != calculator.MaxQuestionIdleSecs || questionTimes[0].Ended {
+		t.Fatalf("expected the idle gap to be capped at %d seconds in an ongoing attempt, got %v", calculator.MaxQuestionIdleSecs, questionTimes)
+	}
+}
+
+func TestNewQuestionTimes_WithoutViewedQuestions_SpendsTimeBeforeAnswer(t *testing.T) {
+	p := participantbuilder.New(startedAt).
+		StartQuiz("quiz", "q1", "q2").
+		At(startedAt.Add(30*time.Second)).SelectAnswer("quiz", "q1", "a1", true).
+		At(startedAt.Add(50*time.Second)).SelectAnswer("quiz", "q2", "a2", true).
+		At(startedAt.Add(90 * time.Second)).FinishQuiz("quiz").
+		Build()
+
+	timeSpentSecs := questiontime.GetAttemptTimeSpentSecs(questiontime.NewQuestionTimes(p), "quiz", 1)
+
+	if timeSpentSecs["q1"] != 30 || timeSpentSecs["q2"] != 20 {
+		t.Fatalf("expected 30 seconds on q1 and 20 seconds on q2, got %v", timeSpentSecs)
+	}
+}
+
+func TestNewQuestionTimes_RegradedAnswer_ChangesAttemptAtVersionOfRegrade(t *testing.T) {
+	p := err.PanicIfError1(participant.NewFromEvents([]eventsource.Event{
+		event.StartedQuiz{QuizID: "quiz", RequiredQuestionsAnswered: []string{"q1"}, EventBase: eventsource.EventBase{Version: 1}},
+		event.SelectedAnswer{QuizID: "quiz", QuestionID: "q1", AnswerID: "a1", IsCorrect: true, EventBase: eventsource.EventBase{Version: 2}},
+		event.FinishedQuiz{QuizID: "quiz", EventBase: eventsource.EventBase{Version: 3}},
+		event.StartedQuiz{QuizID: "quiz", RequiredQuestionsAnswered: []string{"q1"}, EventBase: eventsource.EventBase{Version: 4}},
+		event.SelectedAnswer{QuizID: "quiz", QuestionID: "q1", AnswerID: "a1", IsCorrect: true, EventBase: eventsource.EventBase{Version: 5}},
+		event.FinishedQuiz{QuizID: "quiz", EventBase: eventsource.EventBase{Version: 6}},
+		event.AnswerRegraded{QuizID: "quiz", AttemptID: 1, QuestionID: "q1", AnswerID: "a1", IsCorrect: false, EventBase: eventsource.EventBase{Version: 7}},
+	}, true))
+
+	questionTimes := questiontime.NewQuestionTimes(p)
+
+	if len(questionTimes) != 2 || questionTimes[0].ChangedVersion != 7 || questionTimes[0].IsCorrect || questionTimes[1].ChangedVersion != 6 {
+		t.Fatalf("expected the regraded first attempt to change at version 7 and the second at version 6, got %v", questionTimes)
+	}
+}
@@ -1,9 +1,24 @@
 package projection
 
 type QuizAttemptOverview struct {
-	QuizID               string
-	AttemptID            int
-	Pass                 bool
+	QuizID    string
+	AttemptID int
+
+	// Pass is the result of the attempt itself, only the pass of a graded attempt counts towards the quiz
+	Pass bool
+
 	QuestionsWithAnswer  map[string]string
 	QuestionCorrectRatio float64
+	TimedOut             bool
+	TimeLimitMins        int
+	RemainingTimeSecs    int
+
+	// Graded is false for practice attempts and retakes
+	Graded bool
+
+	// Practice attempts are part of the history but never graded
+	Practice bool
+
+	// RetakeOfAttemptID is the attempt whose wrong answered questions are retaken, retakes are never graded either
+	RetakeOfAttemptID int
 }
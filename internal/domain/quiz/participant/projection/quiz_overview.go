@@ -5,11 +5,15 @@ import (
 	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/calculator"
 	"learn-to-code/internal/domain/quiz/participant/event"
+	"time"
 )
 
 type QuizOverview struct {
 	ActiveQuizzes   map[string][]QuizAttemptOverview
 	FinishedQuizzes map[string][]QuizAttemptOverview
+
+	// NextAttempts tells per attempted quiz when the next attempt is allowed.
+	NextAttempts map[string]participant.NextAttempt
 }
 
 func (qo QuizOverview) GetFinishedQuizLatestAttempt(quizID string) (QuizAttemptOverview, error) {
@@ -27,11 +31,13 @@ func (qo QuizOverview) GetFinishedQuizLatestAttempt(quizID string) (QuizAttemptO
 	return latestQuizAttempt, nil
 }
 
-func NewQuizOverview(p participant.Participant) (QuizOverview, error) {
+// NewQuizOverview creates the overview of all quiz attempts, now is used to calculate the remaining time of timed attempts.
+func NewQuizOverview(p participant.Participant, now time.Time) (QuizOverview, error) {
 
 	qo := QuizOverview{
 		ActiveQuizzes:   map[string][]QuizAttemptOverview{},
 		FinishedQuizzes: map[string][]QuizAttemptOverview{},
+		NextAttempts:    map[string]participant.NextAttempt{},
 	}
 
 	quizAttemptCounter := map[string]int{}
@@ -40,8 +46,9 @@ func NewQuizOverview(p participant.Participant) (QuizOverview, error) {
 	quizResultCalculators := map[string]*calculator.QuizResult{}
 
 	var activeQuizAttempts = map[string]*QuizAttemptOverview{}
+	var activeQuizAttemptStarts = map[string]event.StartedQuiz{}
 
-	for _, generalEvent := range p.GetEvents() {
+	for _, generalEvent := range p.GetRegradedEvents() {
 
 		switch e := generalEvent.(type) {
 
@@ -62,16 +69,21 @@ func NewQuizOverview(p participant.Participant) (QuizOverview, error) {
 				QuizID:              e.QuizID,
 				AttemptID:           quizAttemptCounter[e.QuizID],
 				QuestionsWithAnswer: map[string]string{},
+				TimeLimitMins:       e.TimeLimitMins,
+				Graded:              e.IsGraded(),
+				Practice:            e.Practice,
+				RetakeOfAttemptID:   e.RetakeOfAttemptID,
 			}
+			activeQuizAttemptStarts[e.QuizID] = e
 
 		case event.SelectedAnswer:
 			attemptAnswerCounterIndex := getQuizAttemptResultCalculatorKey(e.QuizID, quizAttemptCounter)
 
-			if e.IsCorrect {
-				quizResultCalculators[attemptAnswerCounterIndex].AddAnswer(e.QuestionID, true)
-			} else {
-				quizResultCalculators[attemptAnswerCounterIndex].AddAnswer(e.QuestionID, false)
-			}
+			quizResultCalculators[attemptAnswerCounterIndex].AddAnswer(e.QuestionID, e.IsCorrect)
+
+		case event.HintRequested:
+			attemptAnswerCounterIndex := getQuizAttemptResultCalculatorKey(e.QuizID, quizAttemptCounter)
+			quizResultCalculators[attemptAnswerCounterIndex].AddHintPenalty(e.QuestionID, e.Penalty)
 
 		case event.FinishedQuiz:
 			incrementQuizFinishCounter(quizFinishCounter, e)
@@ -86,6 +98,17 @@ func NewQuizOverview(p participant.Participant) (QuizOverview, error) {
 
 			activeQuizAttempts[e.QuizID].QuestionCorrectRatio = quizResultCalculators[calculatorKey].GetCorrectRatio()
 
+			qo.FinishedQuizzes[e.QuizID] = append(qo.FinishedQuizzes[e.QuizID], *activeQuizAttempts[e.QuizID])
+			delete(activeQuizAttempts, e.QuizID)
+
+		case event.QuizTimedOut:
+			calculatorKey := getQuizAttemptResultCalculatorKey(e.QuizID, quizAttemptCounter)
+			quizResultCalculators[calculatorKey].AddUnansweredQuestions(activeQuizAttemptStarts[e.QuizID].RequiredQuestionsAnswered)
+
+			activeQuizAttempts[e.QuizID].Pass = quizResultCalculators[calculatorKey].IsPass()
+			activeQuizAttempts[e.QuizID].QuestionCorrectRatio = quizResultCalculators[calculatorKey].GetCorrectRatio()
+			activeQuizAttempts[e.QuizID].TimedOut = true
+
 			qo.FinishedQuizzes[e.QuizID] = append(qo.FinishedQuizzes[e.QuizID], *activeQuizAttempts[e.QuizID])
 			delete(activeQuizAttempts, e.QuizID)
 		}
@@ -102,6 +125,11 @@ func NewQuizOverview(p participant.Participant) (QuizOverview, error) {
 			AttemptID:           activeQuizAttemptOverview.AttemptID,
 			QuestionsWithAnswer: activeQuizAttemptOverview.QuestionsWithAnswer,
 			Pass:                false,
+			Graded:              activeQuizAttemptOverview.Graded,
+			TimeLimitMins:       activeQuizAttemptOverview.TimeLimitMins,
+			RemainingTimeSecs:   activeQuizAttemptStarts[activeQuizID].GetRemainingTimeSecs(now),
+			Practice:            activeQuizAttemptOverview.Practice,
+			RetakeOfAttemptID:   activeQuizAttemptOverview.RetakeOfAttemptID,
 		})
 	}
 
@@ -8,6 +8,7 @@ import (
 	"learn-to-code/internal/infrastructure/go/util/err"
 	"learn-to-code/internal/infrastructure/go/util/uuid"
 	"testing"
+	"time"
 )
 
 func TestGetFinishedQuizLatestAttempt_ExistingQuiz(t *testing.T) {
@@ -18,7 +19,7 @@ func TestGetFinishedQuizLatestAttempt_ExistingQuiz(t *testing.T) {
 	}
 	p := err.PanicIfError1(participant.NewFromEvents(events, true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	latestAttempt, err := qo.GetFinishedQuizLatestAttempt(quizID)
 	if err != nil {
@@ -32,7 +33,7 @@ func TestGetFinishedQuizLatestAttempt_ExistingQuiz(t *testing.T) {
 func TestGetFinishedQuizLatestAttempt_NonExistingQuiz(t *testing.T) {
 	p := newParticipant()
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	_, err := qo.GetFinishedQuizLatestAttempt("non-existing-quiz-id")
 	if err == nil {
@@ -48,7 +49,7 @@ func TestGetFinishedQuizLatestAttempt_NoFinishedAttempt(t *testing.T) {
 	}
 	p := err.PanicIfError1(participant.NewFromEvents(events, true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	_, err := qo.GetFinishedQuizLatestAttempt(quizID)
 	if err == nil {
@@ -63,7 +64,7 @@ func TestNewQuizOverview_NoActiveQuizzes(t *testing.T) {
 	}
 	p := err.PanicIfError1(participant.NewFromEvents(events, true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if len(qo.ActiveQuizzes) != 0 {
 		t.Fatalf("Expected no active quizzes, found some")
@@ -77,7 +78,7 @@ func TestNewQuizOverview_NoFinishedQuizzes(t *testing.T) {
 	}
 	p := err.PanicIfError1(participant.NewFromEvents(events, true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if len(qo.FinishedQuizzes) != 0 {
 		t.Fatalf("Expected no finished quizzes, found some")
@@ -92,7 +93,7 @@ func TestQuizAttemptOverview_QuizAttemptCount(t *testing.T) {
 	}
 	p := err.PanicIfError1(participant.NewFromEvents(events, true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if len(qo.FinishedQuizzes[quizID]) != 2 {
 		t.Fatalf("Expected 2 attempts for the quiz, got %d", len(qo.FinishedQuizzes[quizID]))
@@ -106,7 +107,7 @@ func TestQuizAttemptOverview_PassIsFalseForUnfinishedQuizzes(t *testing.T) {
 	err.PanicIfError(p.StartQuiz(quizID, []string{"a"}))
 	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-1", true))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if qo.ActiveQuizzes[quizID][0].Pass != false {
 		t.Fatalf("Expected pass for not finished quizzes to be false, got %v", qo.ActiveQuizzes[quizID][0].Pass)
@@ -121,13 +122,56 @@ func TestQuizAttemptOverview_PassIsTrueForFinishedCorrectQuizzes(t *testing.T) {
 	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-1", true))
 	err.PanicIfError(p.FinishQuiz(quizID))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if qo.FinishedQuizzes[quizID][0].Pass != true {
 		t.Fatalf("Expected pass for finished and correct quizzes to be true, got %v", qo.FinishedQuizzes[quizID][0].Pass)
 	}
 }
 
+func TestQuizAttemptOverview_PracticeAttemptIsInHistoryUngradedWithResult(t *testing.T) {
+	quizID := "test-quiz-id"
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuizWithSettings(quizID, []string{"a"}, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-1", true))
+	err.PanicIfError(p.FinishQuiz(quizID))
+
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
+
+	finishedAttempt := qo.FinishedQuizzes[quizID][0]
+	if !finishedAttempt.Practice || finishedAttempt.Graded || !finishedAttempt.Pass {
+		t.Fatalf("Expected an ungraded practice attempt that passes, got %+v", finishedAttempt)
+	}
+
+	if finishedAttempt.QuestionCorrectRatio != 1 {
+		t.Fatalf("Expected question correct ratio 1 of the practice attempt, got %v", finishedAttempt.QuestionCorrectRatio)
+	}
+}
+
+func TestQuizAttemptOverview_RetakeIsInHistoryUngradedWithResult(t *testing.T) {
+	quizID := "test-quiz-id"
+
+	p := err.PanicIfError1(participant.New())
+	err.PanicIfError(p.StartQuiz(quizID, []string{"a"}))
+	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-1", false))
+	err.PanicIfError(p.FinishQuiz(quizID))
+	err.PanicIfError(p.StartQuizWithSettings(quizID, nil, participant.AttemptSettings{RetakeAttemptID: 1}))
+	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-2", true))
+	err.PanicIfError(p.FinishQuiz(quizID))
+
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
+
+	retake := qo.FinishedQuizzes[quizID][1]
+	if retake.RetakeOfAttemptID != 1 || retake.Graded || !retake.Pass {
+		t.Fatalf("Expected an ungraded retake of attempt 1 that passes, got %+v", retake)
+	}
+
+	if !qo.FinishedQuizzes[quizID][0].Graded || qo.FinishedQuizzes[quizID][0].Pass {
+		t.Fatalf("Expected the retaken attempt to be graded and failed, got %+v", qo.FinishedQuizzes[quizID][0])
+	}
+}
+
 func TestQuizAttemptOverview_PassIsFalseForFinishedWrongQuizzes(t *testing.T) {
 	quizID := "test-quiz-id"
 
@@ -136,7 +180,7 @@ func TestQuizAttemptOverview_PassIsFalseForFinishedWrongQuizzes(t *testing.T) {
 	err.PanicIfError(p.SelectQuizAnswer(quizID, "a", "a-1", false))
 	err.PanicIfError(p.FinishQuiz(quizID))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if qo.FinishedQuizzes[quizID][0].Pass != false {
 		t.Fatalf("Expected pass for finished and wrong quizzes to be false, got %v", qo.FinishedQuizzes[quizID][0].Pass)
@@ -152,13 +196,75 @@ func TestQuizAttemptOverview_QuestionCorrectRatioExistsForFinishedQuizzes(t *tes
 	err.PanicIfError(p.SelectQuizAnswer(quizID, "b", "b-1", false))
 	err.PanicIfError(p.FinishQuiz(quizID))
 
-	qo := err.PanicIfError1(projection.NewQuizOverview(p))
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
 
 	if qo.FinishedQuizzes[quizID][0].QuestionCorrectRatio != 0.5 {
 		t.Fatalf("Expected QuestionCorrectRatio for finished to be 0.5, got %v", qo.FinishedQuizzes[quizID][0].QuestionCorrectRatio)
 	}
 }
 
+func TestQuizAttemptOverview_RegradedAnswerChangesPassAndQuestionCorrectRatio(t *testing.T) {
+	quizID := "test-quiz-id"
+
+	events := []eventsource.Event{
+		event.StartedQuiz{QuizID: quizID, RequiredQuestionsAnswered: []string{"a"}},
+		event.SelectedAnswer{QuizID: quizID, QuestionID: "a", AnswerID: "a-1", IsCorrect: false},
+		event.FinishedQuiz{QuizID: quizID},
+		event.AnswerRegraded{QuizID: quizID, AttemptID: 1, QuestionID: "a", AnswerID: "a-1", IsCorrect: true},
+	}
+	p := err.PanicIfError1(participant.NewFromEvents(events, true))
+
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
+
+	regradedAttempt := qo.FinishedQuizzes[quizID][0]
+	if !regradedAttempt.Pass || regradedAttempt.QuestionCorrectRatio != 1 {
+		t.Fatalf("Expected the regraded attempt to pass with ratio 1, got pass %v and ratio %v", regradedAttempt.Pass, regradedAttempt.QuestionCorrectRatio)
+	}
+}
+
+func TestQuizAttemptOverview_TimedOutQuizIsFinishedAndCountsUnansweredQuestionsAsWrong(t *testing.T) {
+	quizID := "test-quiz-id"
+	startedAt := time.Now().Add(-time.Hour)
+
+	events := []eventsource.Event{
+		event.StartedQuiz{QuizID: quizID, RequiredQuestionsAnswered: []string{"a", "b"}, TimeLimitMins: 10, EventBase: eventsource.EventBase{CreatedAt: startedAt}},
+		event.SelectedAnswer{QuizID: quizID, QuestionID: "a", AnswerID: "a-1", IsCorrect: true, EventBase: eventsource.EventBase{CreatedAt: startedAt.Add(time.Minute)}},
+		event.QuizTimedOut{QuizID: quizID, EventBase: eventsource.EventBase{CreatedAt: startedAt.Add(time.Hour)}},
+	}
+	p := err.PanicIfError1(participant.NewFromEvents(events, true))
+
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, time.Now()))
+
+	if len(qo.ActiveQuizzes) != 0 {
+		t.Fatalf("Expected no active quizzes after time out, found %d", len(qo.ActiveQuizzes))
+	}
+
+	timedOutAttempt := qo.FinishedQuizzes[quizID][0]
+	if !timedOutAttempt.TimedOut {
+		t.Fatalf("Expected attempt to be marked as timed out")
+	}
+
+	if timedOutAttempt.QuestionCorrectRatio != 0.5 {
+		t.Fatalf("Expected QuestionCorrectRatio of 0.5 for one unanswered question, got %v", timedOutAttempt.QuestionCorrectRatio)
+	}
+}
+
+func TestQuizAttemptOverview_ActiveTimedQuizReturnsRemainingTime(t *testing.T) {
+	quizID := "test-quiz-id"
+	startedAt := time.Now()
+
+	events := []eventsource.Event{
+		event.StartedQuiz{QuizID: quizID, TimeLimitMins: 10, EventBase: eventsource.EventBase{CreatedAt: startedAt}},
+	}
+	p := err.PanicIfError1(participant.NewFromEvents(events, true))
+
+	qo := err.PanicIfError1(projection.NewQuizOverview(p, startedAt.Add(4*time.Minute)))
+
+	if qo.ActiveQuizzes[quizID][0].RemainingTimeSecs != 360 {
+		t.Fatalf("Expected 360 remaining seconds, got %d", qo.ActiveQuizzes[quizID][0].RemainingTimeSecs)
+	}
+}
+
 func newParticipant() participant.Participant {
 	return err.PanicIfError1(participant.NewParticipant(uuid.MustNewRandomAsString()))
 }
@@ -4,6 +4,8 @@ import (
 	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/calculator"
 	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/domain/quiz/participant/projection/questiontime"
+	"learn-to-code/internal/domain/quiz/questionstats"
 	"math"
 	"time"
 )
@@ -12,16 +14,26 @@ type AttemptStatus string
 
 const AttemptStatusOngoing = "ongoing"
 const AttemptStatusFinished = "finished"
+const AttemptStatusTimedOut = "timedOut"
 
-// taken from the last 1000 quizzes solved
+// AverageTimePerQuestionMins is compared with when the quiz stats do not have enough data yet
 const AverageTimePerQuestionMins = 2
 
 type AttemptResult struct {
-	Pass                                    bool
+	// Pass is the result of the attempt itself, only the pass of a graded attempt counts towards the quiz
+	Pass bool
+
+	// Graded is false for practice attempts and retakes
+	Graded bool
+
 	QuestionCorrectRatio                    float64
 	TimeTakenMins                           int
 	ComparedToTimeAveragePercentage         int
 	ComparedToCorrectRatioLastTryPercentage int
+
+	// AverageTimePerQuestionSecs is the average of the quiz stats the time taken is compared with, or the fallback
+	// AverageTimePerQuestionMins without enough data
+	AverageTimePerQuestionSecs float64
 }
 
 type QuizAttemptDetail struct {
@@ -34,32 +46,80 @@ type QuizAttemptDetail struct {
 	AttemptResult AttemptResult
 
 	QuestionsWithAnswer map[string]string
+
+	// TimeLimitMins is 0 for attempts without a time limit
+	TimeLimitMins int
+
+	RemainingTimeSecs int
+
+	Adaptive bool
+
+	// Practice attempts give instant feedback and are never graded
+	Practice bool
+
+	// RetakeOfAttemptID is the attempt whose wrong answered questions are retaken, retakes are never graded either
+	RetakeOfAttemptID int
+
+	// HintsUsed counts the used hints per question, every hint reduces the score of a correct answer
+	HintsUsed map[string]int
+
+	// AbilityScore is the final ability estimate of a finished or timed out adaptive attempt, from -2 (easy) to 2 (hard)
+	AbilityScore float64
+
+	// Calibration compares the confidence with the accuracy of the final answers given with a confidence
+	Calibration calculator.Calibration
+}
+
+type confidentAnswer struct {
+	confidence string
+	isCorrect  bool
+}
+
+// NewQuizAttemptDetail creates the detail of a single attempt, now is used to calculate the remaining time of a timed attempt.
+func NewQuizAttemptDetail(p participant.Participant, quizID string, attemptID int, now time.Time) (QuizAttemptDetail, error) {
+	return NewQuizAttemptDetailWithQuizStats(p, quizID, attemptID, now, questionstats.QuizStats{})
 }
 
-func NewQuizAttemptDetail(p participant.Participant, quizID string, attemptID int) (QuizAttemptDetail, error) {
+// NewQuizAttemptDetailWithQuizStats creates the detail of a single attempt and compares the time taken with the
+// average time per question of all participants in the quiz stats.
+func NewQuizAttemptDetailWithQuizStats(p participant.Participant, quizID string, attemptID int, now time.Time, quizStats questionstats.QuizStats) (QuizAttemptDetail, error) {
 
 	qad := QuizAttemptDetail{
 		QuestionsWithAnswer: map[string]string{},
+		HintsUsed:           map[string]int{},
 	}
 
 	quizCounter := 0
 
+	prevStartedQuiz := event.StartedQuiz{}
+	startedQuiz := event.StartedQuiz{}
 	startQuizTime := time.Time{}
 	endQuizTime := time.Time{}
 
 	prevQuizResultCalculator := calculator.NewQuizResultCalculator()
 	quizResultCalculator := calculator.NewQuizResultCalculator()
 
-	for _, generalEvent := range p.GetEvents() {
+	// only the final answer to a question counts for the calibration
+	confidentAnswers := map[string]confidentAnswer{}
+
+	for _, generalEvent := range p.GetRegradedEvents() {
 
 		switch e := generalEvent.(type) {
 
 		case event.StartedQuiz:
 			if e.QuizID == quizID {
 				quizCounter++
+				if (quizCounter) == attemptID-1 {
+					prevStartedQuiz = e
+				}
 				if (quizCounter) == attemptID {
 					qad.AttemptID = quizCounter
 					qad.AttemptStatus = AttemptStatusOngoing
+					qad.TimeLimitMins = e.TimeLimitMins
+					qad.Adaptive = e.Adaptive
+					qad.Practice = e.Practice
+					qad.RetakeOfAttemptID = e.RetakeOfAttemptID
+					startedQuiz = e
 					startQuizTime = e.CreatedAt
 				}
 			}
@@ -68,30 +128,53 @@ func NewQuizAttemptDetail(p participant.Participant, quizID string, attemptID in
 			if e.QuizID == quizID {
 
 				if (quizCounter) == attemptID-1 {
-					if e.IsCorrect {
-						prevQuizResultCalculator.AddAnswer(e.QuestionID, true)
-					} else {
-						prevQuizResultCalculator.AddAnswer(e.QuestionID, false)
-					}
+					prevQuizResultCalculator.AddAnswer(e.QuestionID, e.IsCorrect)
 				}
 
 				if (quizCounter) == attemptID {
 					qad.QuestionsWithAnswer[e.QuestionID] = e.AnswerID
-					if e.IsCorrect {
-						quizResultCalculator.AddAnswer(e.QuestionID, true)
-					} else {
-						quizResultCalculator.AddAnswer(e.QuestionID, false)
+					quizResultCalculator.AddAnswer(e.QuestionID, e.IsCorrect)
+					confidentAnswers[e.QuestionID] = confidentAnswer{
+						confidence: e.Confidence,
+						isCorrect:  e.IsCorrect,
 					}
 				}
 			}
 
+		case event.HintRequested:
+			if e.QuizID == quizID {
+				if (quizCounter) == attemptID-1 {
+					prevQuizResultCalculator.AddHintPenalty(e.QuestionID, e.Penalty)
+				}
+
+				if (quizCounter) == attemptID {
+					qad.HintsUsed[e.QuestionID]++
+					quizResultCalculator.AddHintPenalty(e.QuestionID, e.Penalty)
+				}
+			}
+
 		case event.FinishedQuiz:
 			if e.QuizID == quizID {
 				if (quizCounter) == attemptID {
 					qad.AttemptStatus = AttemptStatusFinished
+					qad.AbilityScore = e.AbilityScore
 					endQuizTime = e.CreatedAt
 				}
 			}
+
+		case event.QuizTimedOut:
+			if e.QuizID == quizID {
+				if (quizCounter) == attemptID-1 {
+					prevQuizResultCalculator.AddUnansweredQuestions(prevStartedQuiz.RequiredQuestionsAnswered)
+				}
+
+				if (quizCounter) == attemptID {
+					qad.AttemptStatus = AttemptStatusTimedOut
+					qad.AbilityScore = e.AbilityScore
+					quizResultCalculator.AddUnansweredQuestions(startedQuiz.RequiredQuestionsAnswered)
+					endQuizTime = startedQuiz.GetDeadline()
+				}
+			}
 		}
 
 	}
@@ -112,22 +195,60 @@ func NewQuizAttemptDetail(p participant.Participant, quizID string, attemptID in
 		}
 	}
 
-	if qad.AttemptStatus == AttemptStatusFinished {
+	qad.Calibration = calculator.NewCalibration()
+	for _, answer := range confidentAnswers {
+		qad.Calibration.AddAnswer(answer.confidence, answer.isCorrect)
+	}
+
+	if qad.AttemptStatus == AttemptStatusOngoing {
+		qad.RemainingTimeSecs = startedQuiz.GetRemainingTimeSecs(now)
+	}
+
+	if qad.AttemptStatus == AttemptStatusFinished || qad.AttemptStatus == AttemptStatusTimedOut {
 
 		comparedToCorrectRatioLastTryPercentage := quizResultCalculator.GetCorrectnessRatioComparedToOtherQuizResult(prevQuizResultCalculator)
 
 		timeTakenMins := max(int(math.Round(endQuizTime.Sub(startQuizTime).Minutes())), 1)
-		averageTimeMins := float64(len(qad.QuestionsWithAnswer) * AverageTimePerQuestionMins)
-		comparedToTimeAveragePercentage := int(math.Round(((float64(timeTakenMins) / float64(averageTimeMins)) - 1) * 100))
+		averageTimePerQuestionSecs := float64(AverageTimePerQuestionMins * 60)
+		if quizStats.HasEnoughData() {
+			averageTimePerQuestionSecs = quizStats.AverageTimePerQuestionSecs
+		}
+
+		// the quiz stats measure the time of the answered questions with idle gaps capped, so the attempt is compared
+		// by the same measure instead of its wall-clock time
+		answeredQuestionCount, answeredTimeSpentSecs := getAnsweredTimeSpent(questiontime.NewQuestionTimes(p), quizID, attemptID)
+
+		// an attempt that timed out without any answer has no average to compare with
+		averageTimeSecs := float64(answeredQuestionCount) * averageTimePerQuestionSecs
+		comparedToTimeAveragePercentage := 0
+		if averageTimeSecs > 0 {
+			comparedToTimeAveragePercentage = int(math.Round(((float64(answeredTimeSpentSecs) / averageTimeSecs) - 1) * 100))
+		}
 
 		qad.AttemptResult = AttemptResult{
 			Pass:                                    quizResultCalculator.IsPass(),
+			Graded:                                  startedQuiz.IsGraded(),
 			QuestionCorrectRatio:                    quizResultCalculator.GetCorrectRatio(),
 			TimeTakenMins:                           timeTakenMins,
 			ComparedToTimeAveragePercentage:         comparedToTimeAveragePercentage,
 			ComparedToCorrectRatioLastTryPercentage: comparedToCorrectRatioLastTryPercentage,
+			AverageTimePerQuestionSecs:              averageTimePerQuestionSecs,
 		}
 	}
 
 	return qad, nil
 }
+
+// getAnsweredTimeSpent returns the number of answered questions of the attempt and the seconds spent on them.
+func getAnsweredTimeSpent(questionTimes []questiontime.QuestionTime, quizID string, attemptID int) (int, int) {
+	answeredQuestionCount := 0
+	timeSpentSecs := 0
+	for _, questionTime := range questionTimes {
+		if questionTime.QuizID == quizID && questionTime.AttemptID == attemptID && questionTime.Answered {
+			answeredQuestionCount++
+			timeSpentSecs += questionTime.TimeSpentSecs
+		}
+	}
+
+	return answeredQuestionCount, timeSpentSecs
+}
@@ -1,17 +1,27 @@
 package quizattemptdetail
 
 import (
+	"fmt"
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/course"
 	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/domain/quiz/questionstats"
 	"learn-to-code/internal/infrastructure/go/util/err"
 	"learn-to-code/internal/infrastructure/go/util/uuid"
 	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
 	"testing"
+	"time"
 )
 
+// examSettings start attempts without feedback, their answers can be changed until the attempt is finished
+var examSettings = participant.AttemptSettings{Quiz: course.StepQuiz{ID: inmemory.QuizIDEssentialsOfTheWeb, FeedbackDisabled: true}}
+
 func TestNewQuizAttemptDetail_ErrorsForEmptyUsers(t *testing.T) {
 	p := newParticipant()
 
-	_, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1)
+	_, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now())
 
 	if err == nil {
 		t.Fatalf("quiz attempt detail creation returns no error for non existing quiz attempt")
@@ -23,7 +33,7 @@ func TestNewQuizAttemptDetail_ReturnsForSingleQuestionSingleQuizUser(t *testing.
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, true))
 
-	quizAttemptDetailProjection, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1)
+	quizAttemptDetailProjection, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now())
 
 	if err != nil {
 		t.Fatalf("quiz attempt detail creation errors for valid attempt: %v", err)
@@ -32,6 +42,41 @@ func TestNewQuizAttemptDetail_ReturnsForSingleQuestionSingleQuizUser(t *testing.
 	assertQuestionAnswer(t, quizAttemptDetailProjection, inmemory.FirstQuestionID, inmemory.FirstAnswerID)
 }
 
+func TestNewQuizAttemptDetail_FinishedQuizWithHint_CountsHintAndReducesCorrectRatio(t *testing.T) {
+	quiz := err.PanicIfError1(inmemory.NewCourseRepository().FindQuizByID(inmemory.QuizIDEssentialsOfTheWeb))
+
+	p := newParticipant()
+	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
+	err.PanicIfError1(p.RequestHint(quiz, inmemory.FirstQuestionID))
+	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true))
+	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+
+	quizAttemptDetail := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	if quizAttemptDetail.HintsUsed[inmemory.FirstQuestionID] != 1 {
+		t.Fatalf("expected one used hint, got %v", quizAttemptDetail.HintsUsed)
+	}
+
+	if quizAttemptDetail.AttemptResult.QuestionCorrectRatio != 1-quiz.HintPenalty {
+		t.Fatalf("expected the correct ratio reduced by the hint penalty, got %v", quizAttemptDetail.AttemptResult.QuestionCorrectRatio)
+	}
+}
+
+func TestNewQuizAttemptDetail_FinishedQuizWithConfidence_CalibratesFinalAnswers(t *testing.T) {
+	p := newParticipant()
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}, examSettings))
+	err.PanicIfError(p.SelectQuizAnswerWithConfidence(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, false, "high"))
+	err.PanicIfError(p.SelectQuizAnswerWithConfidence(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true, "medium"))
+	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+
+	quizAttemptDetail := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	calibration := quizAttemptDetail.Calibration
+	if calibration.CertaintyScore != 2 || calibration.Levels[1].CorrectAnswers != 1 || calibration.Levels[2].Answers != 0 {
+		t.Fatalf("expected only the final correct answer with medium confidence to count, got %+v", calibration)
+	}
+}
+
 func TestNewQuizAttemptDetail_StartedQuiz_ReturnsOngoingAttemptState(t *testing.T) {
 	p := newParticipant()
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
@@ -41,7 +86,7 @@ func TestNewQuizAttemptDetail_StartedQuiz_ReturnsOngoingAttemptState(t *testing.
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, true))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptStatus != AttemptStatusOngoing {
 		t.Fatalf("started quiz is not ongoing")
@@ -52,7 +97,7 @@ func TestNewQuizAttemptDetail_StartedQuiz_ReturnsValidAttemptID(t *testing.T) {
 	p := newParticipant()
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{inmemory.FirstQuestionID}))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
 
 	if quizAttemptDetailProjection.AttemptID != 1 {
 		t.Fatalf("started quiz has not attemptID 1, it has instead %d", quizAttemptDetailProjection.AttemptID)
@@ -64,7 +109,7 @@ func TestNewQuizAttemptDetail_FinishedQuiz_ReturnsFinishedAttemptState(t *testin
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{}))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptStatus != AttemptStatusFinished {
 		t.Fatalf("finished quiz is not in finished state")
@@ -76,7 +121,7 @@ func TestNewQuizAttemptDetail_FinishedQuiz_ReturnsQuizResultPass(t *testing.T) {
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{}))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.Pass != false {
 		t.Fatalf("finished quiz without questions did pass but should fail")
@@ -89,7 +134,7 @@ func TestNewQuizAttemptDetail_FinishedQuizWithAllWrongAnswers_ReturnsQuizResultN
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q1", "a1", false))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.Pass != false {
 		t.Fatalf("finished quiz with only wrong answers did pass")
@@ -106,13 +151,26 @@ func TestNewQuizAttemptDetail_FinishedQuizWithMostCorrectAnswers_ReturnsQuizResu
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q5", "a5", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.Pass != true {
 		t.Fatalf("finished quiz with mostly correct answers did not pass")
 	}
 }
 
+func TestNewQuizAttemptDetail_FinishedPracticeAttempt_ReturnsUngradedPass(t *testing.T) {
+	p := newParticipant()
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1"}, participant.AttemptSettings{Mode: participant.AttemptModePractice}))
+	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q1", "a1", true))
+	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	if !quizAttemptDetailProjection.AttemptResult.Pass || quizAttemptDetailProjection.AttemptResult.Graded {
+		t.Fatalf("expected the ungraded pass of the practice attempt, got %+v", quizAttemptDetailProjection.AttemptResult)
+	}
+}
+
 func TestNewQuizAttemptDetail_FinishedQuizSeveralIncorrectAnswers_ReturnsQuizResultNotPass(t *testing.T) {
 	p := newParticipant()
 	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2", "q3", "q4", "q5"}))
@@ -123,7 +181,7 @@ func TestNewQuizAttemptDetail_FinishedQuizSeveralIncorrectAnswers_ReturnsQuizRes
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q5", "a5", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.Pass != false {
 		t.Fatalf("finished quiz with some incorrect answers did pass")
@@ -140,7 +198,7 @@ func TestNewQuizAttemptDetail_FinishedQuizSeveralIncorrectAnswers_ReturnsCorrect
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q5", "a5", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio != 0.6 {
 		t.Fatalf("expected correctness ratio of 0.8 but was %f", quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio)
@@ -150,36 +208,48 @@ func TestNewQuizAttemptDetail_FinishedQuizSeveralIncorrectAnswers_ReturnsCorrect
 func TestNewQuizAttemptDetail_FinishedQuizWithDuplicatedAnswers_ReturnsCorrectnessRatio(t *testing.T) {
 	p := newParticipant()
 
-	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2", "q3"}))
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2", "q3"}, examSettings))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q1", "a1", true))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a2", false))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a2", true))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q3", "a3", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio != 1 {
 		t.Fatalf("expected correctness ratio of 1 but was %f", quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio)
 	}
 }
 
-func TestNewQuizAttemptDetail_FinishedQuizSeveralIncorrectAnswers_ReturnsComparedToAverageRatio(t *testing.T) {
-	p := newParticipant()
-	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2", "q3", "q4", "q5"}))
-	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q1", "a1", true))
-	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a2", true))
-	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q3", "a3", true))
-	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q4", "a4", true))
-	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q5", "a5", true))
-	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
+func TestNewQuizAttemptDetail_FinishedQuiz_ComparesCappedTimeOfAnsweredQuestionsWithAverage(t *testing.T) {
+	// the 30 minutes before the first answer only count up to the idle cap
+	p := createParticipantAnsweringAfterSecs(1800, 60)
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	// 300s and 60s spent are 50 percent above the 2 questions of 2m each
+	if quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage != 50 {
+		t.Fatalf("expected compared to average time percentage of 50 percentage but was %d", quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage)
+	}
+}
+
+func TestNewQuizAttemptDetailWithQuizStats_ComparesTimeWithQuizStats(t *testing.T) {
+	p := createParticipantAnsweringAfterSecs(30, 30)
+
+	quizStats := questionstats.QuizStats{QuizID: inmemory.QuizIDEssentialsOfTheWeb, AttemptCount: questionstats.MinQuizAttemptCount, AverageTimePerQuestionSecs: 15}
+	quizAttemptDetail := err.PanicIfError1(NewQuizAttemptDetailWithQuizStats(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now(), quizStats))
+
+	// the 60s spent are twice the 30s the quiz takes on average for 2 questions
+	if quizAttemptDetail.AttemptResult.ComparedToTimeAveragePercentage != 100 || quizAttemptDetail.AttemptResult.AverageTimePerQuestionSecs != 15 {
+		t.Fatalf("expected 100 percent above the average of the quiz stats, got %+v", quizAttemptDetail.AttemptResult)
+	}
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizStats.AttemptCount = questionstats.MinQuizAttemptCount - 1
+	quizAttemptDetail = err.PanicIfError1(NewQuizAttemptDetailWithQuizStats(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now(), quizStats))
 
-	// the minimum duration for the calculation is 1m, and we have 10 questions for each question 1m
-	// means 1/10 - 1 = -0.9 * 100 = -90
-	if quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage != -90 {
-		t.Fatalf("expected compared to average time percentage of -90 percentage but was %d", quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage)
+	if quizAttemptDetail.AttemptResult.ComparedToTimeAveragePercentage != -75 || quizAttemptDetail.AttemptResult.AverageTimePerQuestionSecs != AverageTimePerQuestionMins*60 {
+		t.Fatalf("expected the fallback average without enough attempts, got %+v", quizAttemptDetail.AttemptResult)
 	}
 }
 
@@ -190,7 +260,7 @@ func TestNewQuizAttemptDetail_FinishedQuiz_ReturnsTimeTaken(t *testing.T) {
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a2", false))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.TimeTakenMins != 1 {
 		t.Fatalf("expected 1 time taken for the quiz")
@@ -204,7 +274,7 @@ func TestNewQuizAttemptDetail_SingleFinishedQuiz_ReturnsCompareLastTryPercentage
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a2", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.ComparedToCorrectRatioLastTryPercentage != 100 {
 		t.Fatalf("expected 100 percent as compared to last try percentage because there was no last try, but was %d", quizAttemptDetailProjection.AttemptResult.ComparedToCorrectRatioLastTryPercentage)
@@ -224,7 +294,7 @@ func TestNewQuizAttemptDetail_TwoFinishedQuizzes_ReturnsCompareLastTryPercentage
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q2", "a3", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb)))
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, p.GetQuizAttemptCount(inmemory.QuizIDEssentialsOfTheWeb), time.Now()))
 
 	if quizAttemptDetailProjection.AttemptResult.ComparedToCorrectRatioLastTryPercentage != 50 {
 		t.Fatalf("expected 50 percent as compared to last try, but was %d", quizAttemptDetailProjection.AttemptResult.ComparedToCorrectRatioLastTryPercentage)
@@ -234,10 +304,10 @@ func TestNewQuizAttemptDetail_TwoFinishedQuizzes_ReturnsCompareLastTryPercentage
 func TestNewQuizAttemptDetail_ReturnsForFinishedQuiz(t *testing.T) {
 	p := newParticipant()
 
-	err.PanicIfError(p.StartQuiz("otherQuizId", []string{"z"}))
+	err.PanicIfError(p.StartQuizWithSettings("otherQuizId", []string{"z"}, participant.AttemptSettings{Quiz: course.StepQuiz{ID: "otherQuizId", FeedbackDisabled: true}}))
 	err.PanicIfError(p.SelectQuizAnswer("otherQuizId", "z", "z-1", true))
 
-	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{"a", "b", "c", "d"}))
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"a", "b", "c", "d"}, examSettings))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "a", "a-1", true))
 	err.PanicIfError(p.SelectQuizAnswer("otherQuizId", "otherQuestionId", inmemory.FirstAnswerID, true))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "b", "b-1", true))
@@ -246,7 +316,7 @@ func TestNewQuizAttemptDetail_ReturnsForFinishedQuiz(t *testing.T) {
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "d", "d-3", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	err.PanicIfError(p.StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, []string{"a", "b", "c", "d"}))
+	err.PanicIfError(p.StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"a", "b", "c", "d"}, examSettings))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "a", "a-2", true))
 	err.PanicIfError(p.SelectQuizAnswer("otherQuizId", "otherQuestionId", inmemory.FirstAnswerID, true))
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "b", "b-2", true))
@@ -255,7 +325,7 @@ func TestNewQuizAttemptDetail_ReturnsForFinishedQuiz(t *testing.T) {
 	err.PanicIfError(p.SelectQuizAnswer(inmemory.QuizIDEssentialsOfTheWeb, "d", "d-4", true))
 	err.PanicIfError(p.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb))
 
-	quizAttemptDetailProjection, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1)
+	quizAttemptDetailProjection, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now())
 
 	if err != nil {
 		t.Fatalf("quiz attempt detail creation errors for valid attempt")
@@ -267,7 +337,7 @@ func TestNewQuizAttemptDetail_ReturnsForFinishedQuiz(t *testing.T) {
 	assertQuestionAnswer(t, quizAttemptDetailProjection, "a", "a-1")
 	assertQuestionAnswer(t, quizAttemptDetailProjection, "c", "c-2")
 
-	quizAttemptDetailProjection2, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 2)
+	quizAttemptDetailProjection2, err := NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 2, time.Now())
 
 	if err != nil {
 		t.Fatalf("quiz attempt detail creation errors for valid attempt")
@@ -280,6 +350,77 @@ func TestNewQuizAttemptDetail_ReturnsForFinishedQuiz(t *testing.T) {
 	assertQuestionAnswer(t, quizAttemptDetailProjection2, "c", "c-3")
 }
 
+func TestNewQuizAttemptDetail_TimedOutQuiz_ReturnsTimedOutStateAndResult(t *testing.T) {
+	p := participantbuilder.New(time.Now().Add(-time.Hour)).
+		StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2"}, participant.AttemptSettings{TimeLimitMins: 10}).
+		After(time.Minute).
+		SelectAnswer(inmemory.QuizIDEssentialsOfTheWeb, "q1", "a1", true).
+		At(time.Now()).
+		Build()
+	err.PanicIfError1(p.TimeOutExpiredQuizzes(inmemory.NewCourseRepository()))
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	if quizAttemptDetailProjection.AttemptStatus != AttemptStatusTimedOut {
+		t.Fatalf("expected attempt status %s, got %s", AttemptStatusTimedOut, quizAttemptDetailProjection.AttemptStatus)
+	}
+
+	if quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio != 0.5 {
+		t.Fatalf("expected correctness ratio of 0.5 but was %f", quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio)
+	}
+
+	if quizAttemptDetailProjection.AttemptResult.TimeTakenMins != 10 {
+		t.Fatalf("expected the time limit as time taken for a timed out attempt, got %d", quizAttemptDetailProjection.AttemptResult.TimeTakenMins)
+	}
+}
+
+func TestNewQuizAttemptDetail_TimedOutQuizWithoutAnswers_ReturnsZeroComparedToAverage(t *testing.T) {
+	p := participantbuilder.New(time.Now().Add(-time.Hour)).
+		StartQuizWithSettings(inmemory.QuizIDEssentialsOfTheWeb, []string{"q1", "q2"}, participant.AttemptSettings{TimeLimitMins: 10}).
+		At(time.Now()).
+		Build()
+	err.PanicIfError1(p.TimeOutExpiredQuizzes(inmemory.NewCourseRepository()))
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	if quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage != 0 {
+		t.Fatalf("expected no comparison without answers, got %d", quizAttemptDetailProjection.AttemptResult.ComparedToTimeAveragePercentage)
+	}
+}
+
+func TestNewQuizAttemptDetail_FinishedAdaptiveQuiz_ReturnsAbilityScore(t *testing.T) {
+	startedAt := time.Now().Add(-time.Hour)
+	p := err.PanicIfError1(participant.NewFromEvents([]eventsource.Event{
+		event.StartedQuiz{QuizID: inmemory.QuizIDEssentialsOfTheWeb, Adaptive: true, EventBase: eventsource.EventBase{CreatedAt: startedAt}},
+		event.SelectedAnswer{QuizID: inmemory.QuizIDEssentialsOfTheWeb, QuestionID: "q1", AnswerID: "a1", IsCorrect: true, EventBase: eventsource.EventBase{CreatedAt: startedAt.Add(time.Minute)}},
+		event.FinishedQuiz{QuizID: inmemory.QuizIDEssentialsOfTheWeb, AbilityScore: 1.25, EventBase: eventsource.EventBase{CreatedAt: startedAt.Add(2 * time.Minute)}},
+	}, true))
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, inmemory.QuizIDEssentialsOfTheWeb, 1, time.Now()))
+
+	if !quizAttemptDetailProjection.Adaptive || quizAttemptDetailProjection.AbilityScore != 1.25 {
+		t.Fatalf("expected adaptive attempt with ability score 1.25, got %v", quizAttemptDetailProjection)
+	}
+}
+
+func TestNewQuizAttemptDetail_RegradedAnswer_ReturnsRegradedResult(t *testing.T) {
+	quizID := "test-quiz-id"
+
+	events := []eventsource.Event{
+		event.StartedQuiz{QuizID: quizID, RequiredQuestionsAnswered: []string{"a"}},
+		event.SelectedAnswer{QuizID: quizID, QuestionID: "a", AnswerID: "a-1", IsCorrect: true},
+		event.FinishedQuiz{QuizID: quizID},
+		event.AnswerRegraded{QuizID: quizID, AttemptID: 1, QuestionID: "a", AnswerID: "a-1", IsCorrect: false},
+	}
+	p := err.PanicIfError1(participant.NewFromEvents(events, true))
+
+	quizAttemptDetailProjection := err.PanicIfError1(NewQuizAttemptDetail(p, quizID, 1, time.Now()))
+
+	if quizAttemptDetailProjection.AttemptResult.Pass || quizAttemptDetailProjection.AttemptResult.QuestionCorrectRatio != 0 {
+		t.Fatalf("expected the regraded attempt to fail with ratio 0, got %v", quizAttemptDetailProjection.AttemptResult)
+	}
+}
+
 func assertQuestionAnswer(t *testing.T, quizAttemptDetailProjection QuizAttemptDetail, questionID string, questionAnswer string) {
 	providedAnswer, ok := quizAttemptDetailProjection.QuestionsWithAnswer[questionID]
 
@@ -295,3 +436,22 @@ func assertQuestionAnswer(t *testing.T, quizAttemptDetailProjection QuizAttemptD
 func newParticipant() participant.Participant {
 	return err.PanicIfError1(participant.NewParticipant(uuid.MustNewRandomAsString()))
 }
+
+// createParticipantAnsweringAfterSecs finishes an attempt whose questions are answered one after another, every answer
+// the given seconds after the previous activity.
+func createParticipantAnsweringAfterSecs(secsBeforeAnswers ...int) participant.Participant {
+	var questionIDs []string
+	for i := range secsBeforeAnswers {
+		questionIDs = append(questionIDs, fmt.Sprintf("q%d", i+1))
+	}
+
+	b := participantbuilder.New(time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)).
+		StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, questionIDs...)
+
+	for i, secsBeforeAnswer := range secsBeforeAnswers {
+		b.After(time.Duration(secsBeforeAnswer)*time.Second).
+			SelectAnswer(inmemory.QuizIDEssentialsOfTheWeb, questionIDs[i], "a", true)
+	}
+
+	return b.FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb).Build()
+}
@@ -0,0 +1,122 @@
+package reviewqueue
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"sort"
+	"time"
+)
+
+type ReviewItem struct {
+	QuizID     string
+	QuestionID string
+
+	calculator.ReviewSchedule
+}
+
+// ReviewQueue schedules questions answered wrong or barely remembered in quiz attempts for spaced repetition.
+// Reviews update the schedule but never count as quiz attempts.
+type ReviewQueue struct {
+	Items map[string]ReviewItem
+}
+
+type attemptQuestion struct {
+	hasWrongAnswer bool
+	isCorrect      bool
+}
+
+func NewReviewQueue(p participant.Participant) ReviewQueue {
+	rq := ReviewQueue{
+		Items: map[string]ReviewItem{},
+	}
+
+	startedQuizzes := map[string]event.StartedQuiz{}
+	attemptQuestions := map[string]map[string]*attemptQuestion{}
+
+	for _, generalEvent := range p.GetRegradedEvents() {
+
+		switch e := generalEvent.(type) {
+
+		case event.StartedQuiz:
+			startedQuizzes[e.QuizID] = e
+			attemptQuestions[e.QuizID] = map[string]*attemptQuestion{}
+
+		case event.SelectedAnswer:
+			question, ok := attemptQuestions[e.QuizID][e.QuestionID]
+			if !ok {
+				question = &attemptQuestion{}
+				attemptQuestions[e.QuizID][e.QuestionID] = question
+			}
+
+			question.isCorrect = e.IsCorrect
+			if !e.IsCorrect {
+				question.hasWrongAnswer = true
+			}
+
+		case event.FinishedQuiz:
+			rq.scheduleAttempt(e.QuizID, attemptQuestions[e.QuizID], e.CreatedAt)
+
+		case event.QuizTimedOut:
+			rq.scheduleAttempt(e.QuizID, attemptQuestions[e.QuizID], startedQuizzes[e.QuizID].GetDeadline())
+
+		case event.ReviewedQuestion:
+			quality := e.Quality
+			if !e.IsCorrect {
+				quality = min(quality, calculator.ReviewQualityForgotten)
+			}
+
+			rq.review(e.QuizID, e.QuestionID, quality, e.CreatedAt)
+		}
+	}
+
+	return rq
+}
+
+// GetDueReviews returns all items due at now, the longest overdue item first.
+func (rq ReviewQueue) GetDueReviews(now time.Time) []ReviewItem {
+	dueReviews := []ReviewItem{}
+	for _, item := range rq.Items {
+		if !item.DueAt.After(now) {
+			dueReviews = append(dueReviews, item)
+		}
+	}
+
+	sort.Slice(dueReviews, func(i, j int) bool {
+		if dueReviews[i].DueAt.Equal(dueReviews[j].DueAt) {
+			return dueReviews[i].QuestionID < dueReviews[j].QuestionID
+		}
+		return dueReviews[i].DueAt.Before(dueReviews[j].DueAt)
+	})
+
+	return dueReviews
+}
+
+func (rq ReviewQueue) scheduleAttempt(quizID string, questions map[string]*attemptQuestion, finishedAt time.Time) {
+	for questionID, question := range questions {
+		if !question.hasWrongAnswer {
+			continue
+		}
+
+		quality := calculator.ReviewQualityForgotten
+		if question.isCorrect {
+			quality = calculator.ReviewQualityBarelyRemembered
+		}
+
+		rq.review(quizID, questionID, quality, finishedAt)
+	}
+}
+
+func (rq ReviewQueue) review(quizID string, questionID string, quality int, reviewedAt time.Time) {
+	item, ok := rq.Items[questionID]
+	if !ok {
+		item = ReviewItem{
+			QuizID:         quizID,
+			QuestionID:     questionID,
+			ReviewSchedule: calculator.NewReviewSchedule(),
+		}
+	}
+
+	item.ReviewSchedule = item.ReviewSchedule.Next(quality, reviewedAt)
+	rq.Items[questionID] = item
+}
@@ -0,0 +1,94 @@
+package reviewqueue_test
+
+import (
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/projection/reviewqueue"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/inmemory"
+	"learn-to-code/internal/infrastructure/testing/participantbuilder"
+	"testing"
+	"time"
+)
+
+func TestNewReviewQueue_SchedulesWrongAnswerOfFinishedAttemptForNextDay(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithFinishedAttempt(finishedAt, false)
+
+	rq := reviewqueue.NewReviewQueue(p)
+
+	if len(rq.GetDueReviews(finishedAt)) != 0 {
+		t.Fatalf("expected no due reviews right after the attempt")
+	}
+
+	dueReviews := rq.GetDueReviews(finishedAt.AddDate(0, 0, 1))
+	if len(dueReviews) != 1 || dueReviews[0].QuestionID != inmemory.FirstQuestionID {
+		t.Fatalf("expected question %s to be due the next day, got %v", inmemory.FirstQuestionID, dueReviews)
+	}
+}
+
+func TestNewReviewQueue_IgnoresCorrectAnswers(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithFinishedAttempt(finishedAt, true)
+
+	rq := reviewqueue.NewReviewQueue(p)
+
+	if len(rq.Items) != 0 {
+		t.Fatalf("expected no scheduled reviews for correct answers, got %v", rq.Items)
+	}
+}
+
+func TestNewReviewQueue_AnswerRegradedToCorrect_IsNotScheduled(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithFinishedAttempt(finishedAt, false)
+	correctedCourse := course.Course{
+		ID: "course",
+		Steps: []course.Step{
+			{ID: "step", Quizzes: []course.StepQuiz{{ID: inmemory.QuizIDEssentialsOfTheWeb, Questions: []course.QuizQuestion{
+				{ID: inmemory.FirstQuestionID, Answers: []course.QuizAnswer{{ID: inmemory.FirstAnswerID, IsCorrect: true}}},
+			}}}},
+		},
+	}
+	err.PanicIfError1(p.RegradeAnswers(correctedCourse))
+
+	rq := reviewqueue.NewReviewQueue(p)
+
+	if len(rq.Items) != 0 {
+		t.Fatalf("expected no scheduled reviews for answers regraded to correct, got %v", rq.Items)
+	}
+}
+
+func TestNewReviewQueue_ReviewedQuestionExtendsInterval(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithFinishedAttempt(finishedAt, false)
+	err.PanicIfError(p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true, 4))
+	err.PanicIfError(p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true, 4))
+
+	item := reviewqueue.NewReviewQueue(p).Items[inmemory.FirstQuestionID]
+
+	if item.Repetitions != 2 || item.IntervalDays != 6 {
+		t.Fatalf("expected 2 repetitions with an interval of 6 days, got %d repetitions with %d days", item.Repetitions, item.IntervalDays)
+	}
+}
+
+func TestNewReviewQueue_WrongReviewResetsRepetitions(t *testing.T) {
+	finishedAt := time.Date(2023, 11, 17, 10, 0, 0, 0, time.UTC)
+	p := createParticipantWithFinishedAttempt(finishedAt, false)
+	err.PanicIfError(p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstCorrectAnswerID, true, 5))
+	err.PanicIfError(p.ReviewQuestion(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, false, 5))
+
+	item := reviewqueue.NewReviewQueue(p).Items[inmemory.FirstQuestionID]
+
+	if item.Repetitions != 0 || item.IntervalDays != 1 {
+		t.Fatalf("expected repetitions to restart after a wrong review, got %d repetitions with %d days", item.Repetitions, item.IntervalDays)
+	}
+}
+
+func createParticipantWithFinishedAttempt(finishedAt time.Time, isCorrect bool) participant.Participant {
+	return participantbuilder.New(finishedAt.Add(-10*time.Minute)).
+		StartQuiz(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID).
+		SelectAnswer(inmemory.QuizIDEssentialsOfTheWeb, inmemory.FirstQuestionID, inmemory.FirstAnswerID, isCorrect).
+		At(finishedAt).
+		FinishQuiz(inmemory.QuizIDEssentialsOfTheWeb).
+		Build()
+}
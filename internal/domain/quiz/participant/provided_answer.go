@@ -4,4 +4,7 @@ type ProvidedAnswer struct {
 	AnswerID   string
 	QuestionID string
 	IsCorrect  bool
+
+	// Confidence is low, medium or high, empty for answers without confidence
+	Confidence string
 }
@@ -0,0 +1,49 @@
+package participant
+
+import (
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/go/util/uuid"
+)
+
+// ReportQuestionIssue reports a problem with a question to the moderators and returns the id of the report.
+func (p *Participant) ReportQuestionIssue(quizID string, questionID string, category string, text string) (string, error) {
+	questionIssueReportedEvent := event.QuestionIssueReported{
+		ReportID:   uuid.MustNewRandomAsString(),
+		QuizID:     quizID,
+		QuestionID: questionID,
+		Category:   category,
+		Text:       text,
+		EventBase:  p.createEventBaseEvent(),
+	}
+
+	err := p.apply(questionIssueReportedEvent, false)
+	if err != nil {
+		return "", err
+	}
+
+	return questionIssueReportedEvent.ReportID, nil
+}
+
+// GetQuestionIssueReports returns every reported question issue in the order of reporting, the status is always open
+// because moderation decisions are part of the moderation queue.
+func (p *Participant) GetQuestionIssueReports() []moderation.Report {
+	var reports []moderation.Report
+
+	for _, e := range p.GetEvents() {
+		if questionIssueReported, ok := e.(event.QuestionIssueReported); ok {
+			reports = append(reports, moderation.Report{
+				ID:            questionIssueReported.ReportID,
+				ParticipantID: p.id,
+				QuizID:        questionIssueReported.QuizID,
+				QuestionID:    questionIssueReported.QuestionID,
+				Category:      questionIssueReported.Category,
+				Text:          questionIssueReported.Text,
+				ReportedAt:    questionIssueReported.CreatedAt,
+				Status:        moderation.StatusOpen,
+			})
+		}
+	}
+
+	return reports
+}
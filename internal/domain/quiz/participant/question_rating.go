@@ -0,0 +1,44 @@
+package participant
+
+import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"sort"
+)
+
+var ErrInvalidQuestionRating = errors.New("question rating must be between 1 and 5 stars")
+
+const QuestionRatingMinStars = 1
+const QuestionRatingMaxStars = 5
+
+type QuestionRating struct {
+	QuizID     string
+	QuestionID string
+	Stars      int
+}
+
+// RateQuestion rates the question, every participant has a single rating per question that is replaced when rating again.
+func (p *Participant) RateQuestion(quizID string, questionID string, stars int) error {
+	questionRatedEvent := event.QuestionRated{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		Stars:      stars,
+		EventBase:  p.createEventBaseEvent(),
+	}
+
+	return p.apply(questionRatedEvent, false)
+}
+
+// GetQuestionRatings returns the current rating of every rated question sorted by question id.
+func (p *Participant) GetQuestionRatings() []QuestionRating {
+	questionRatings := make([]QuestionRating, 0, len(p.questionRatings))
+	for _, questionRating := range p.questionRatings {
+		questionRatings = append(questionRatings, questionRating)
+	}
+
+	sort.Slice(questionRatings, func(i, j int) bool {
+		return questionRatings[i].QuestionID < questionRatings[j].QuestionID
+	})
+
+	return questionRatings
+}
@@ -0,0 +1,39 @@
+package participant
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/participant/event"
+)
+
+// ViewQuestion records that the question of the ongoing attempt is shown to the participant.
+func (p *Participant) ViewQuestion(quizID string, questionID string) error {
+	questionViewedEvent := event.QuestionViewed{
+		QuizID:     quizID,
+		QuestionID: questionID,
+		EventBase:  p.createEventBaseEvent(),
+	}
+
+	return p.apply(questionViewedEvent, false)
+}
+
+func (p *Participant) viewQuestion(e event.QuestionViewed) error {
+	quizAttempts, ok := p.quizAttempts[e.QuizID]
+	if !ok {
+		return fmt.Errorf("lastQuizAttempt %v not found", e.QuizID)
+	}
+
+	lastQuizAttempt := p.getLatestQuizAttempt(quizAttempts)
+	if lastQuizAttempt.completed {
+		return fmt.Errorf("can not view a question of quiz %v that is already completed", e.QuizID)
+	}
+
+	if lastQuizAttempt.isExpiredAt(e.CreatedAt) {
+		return fmt.Errorf("can not view a question of quiz %v: %w", e.QuizID, ErrTimeLimitExceeded)
+	}
+
+	if !lastQuizAttempt.isQuestionDrawn(e.QuestionID) {
+		return fmt.Errorf("question %v was not drawn for the attempt of quiz %v", e.QuestionID, e.QuizID)
+	}
+
+	return nil
+}
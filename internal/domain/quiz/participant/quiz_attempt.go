@@ -1,18 +1,156 @@
 package participant
 
-import "fmt"
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"time"
+)
 
 type quizAttempt struct {
 	QuizID                    string
 	providedAnswers           []ProvidedAnswer
 	completed                 bool
+	timedOut                  bool
 	requiredQuestionsAnswered []string
+	startedAt                 time.Time
+	finishedAt                time.Time
+	timeLimitMins             int
+	questionOrder             []string
+	answerOrders              map[string][]string
+	drawnQuestionIDs          []string
+	adaptive                  bool
+	abilityScore              float64
+	practice                  bool
+	retakeOfAttemptID         int
+	usedHints                 []usedHint
+	answersLocked             bool
 }
 
 func (q quizAttempt) IsOngoing() bool {
 	return !q.completed
 }
 
+// orderQuiz returns the quiz with the questions of the attempt in the order of the attempt, attempts without a stored
+// order keep the order of the quiz.
+func (q quizAttempt) orderQuiz(quiz course.StepQuiz) course.StepQuiz {
+	return quiz.WithQuestions(q.drawnQuestionIDs).Ordered(q.questionOrder, q.answerOrders)
+}
+
+func (q quizAttempt) isQuestionDrawn(questionID string) bool {
+	if q.drawnQuestionIDs == nil {
+		return true
+	}
+
+	for _, drawnQuestionID := range q.drawnQuestionIDs {
+		if drawnQuestionID == questionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q quizAttempt) isAnswered(questionID string) bool {
+	for _, providedAnswer := range q.providedAnswers {
+		if providedAnswer.QuestionID == questionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isGraded returns true for exam attempts of the whole quiz, practice attempts and retakes are never graded.
+func (q quizAttempt) isGraded() bool {
+	return !q.practice && q.retakeOfAttemptID == 0
+}
+
+// isGradedPass returns true if the attempt is a completed and passed graded attempt.
+func (q quizAttempt) isGradedPass() bool {
+	return q.completed && q.isGraded() && q.isPass()
+}
+
+// getWrongQuestionIDs returns the questions whose final answer is wrong in the order they were first answered. A timed
+// out attempt scores its unanswered questions as wrong, they follow the answered ones.
+func (q quizAttempt) getWrongQuestionIDs() []string {
+	var answeredQuestionIDs []string
+	isCorrect := map[string]bool{}
+	for _, providedAnswer := range q.providedAnswers {
+		if _, ok := isCorrect[providedAnswer.QuestionID]; !ok {
+			answeredQuestionIDs = append(answeredQuestionIDs, providedAnswer.QuestionID)
+		}
+		isCorrect[providedAnswer.QuestionID] = providedAnswer.IsCorrect
+	}
+
+	var wrongQuestionIDs []string
+	for _, questionID := range answeredQuestionIDs {
+		if !isCorrect[questionID] {
+			wrongQuestionIDs = append(wrongQuestionIDs, questionID)
+		}
+	}
+
+	if q.timedOut {
+		for _, questionID := range q.requiredQuestionsAnswered {
+			if _, ok := isCorrect[questionID]; !ok {
+				wrongQuestionIDs = append(wrongQuestionIDs, questionID)
+			}
+		}
+	}
+
+	return wrongQuestionIDs
+}
+
+func (q quizAttempt) getUsedHintCount(questionID string) int {
+	usedHintCount := 0
+	for _, usedHint := range q.usedHints {
+		if usedHint.questionID == questionID {
+			usedHintCount++
+		}
+	}
+
+	return usedHintCount
+}
+
+func (q quizAttempt) isTimed() bool {
+	return q.timeLimitMins > 0
+}
+
+func (q quizAttempt) deadline() time.Time {
+	return q.startedAt.Add(time.Duration(q.timeLimitMins) * time.Minute)
+}
+
+func (q quizAttempt) isExpiredAt(t time.Time) bool {
+	return q.isTimed() && t.After(q.deadline())
+}
+
+// isPass calculates the result of a completed attempt, unanswered questions of a timed out attempt count as wrong.
+func (q quizAttempt) isPass() bool {
+	return q.result().IsPass()
+}
+
+// isPerfect returns true if every question of the attempt is answered correctly.
+func (q quizAttempt) isPerfect() bool {
+	return q.isPass() && q.result().GetCorrectRatio() == 1
+}
+
+func (q quizAttempt) result() *calculator.QuizResult {
+	quizResultCalculator := calculator.NewQuizResultCalculator()
+	for _, providedAnswer := range q.providedAnswers {
+		quizResultCalculator.AddAnswer(providedAnswer.QuestionID, providedAnswer.IsCorrect)
+	}
+
+	for _, usedHint := range q.usedHints {
+		quizResultCalculator.AddHintPenalty(usedHint.questionID, usedHint.penalty)
+	}
+
+	if q.timedOut {
+		quizResultCalculator.AddUnansweredQuestions(q.requiredQuestionsAnswered)
+	}
+
+	return quizResultCalculator
+}
+
 func (q quizAttempt) checkFinishAttemptValidity() error {
 	err := q.checkIfAllAnswersProvided()
 	if err != nil {
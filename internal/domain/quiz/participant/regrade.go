@@ -0,0 +1,205 @@
+package participant
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/event"
+)
+
+// AnswerRegrade is a provided answer whose correctness differs from the current answer key of the course.
+type AnswerRegrade struct {
+	QuizID     string
+	AttemptID  int
+	QuestionID string
+	AnswerID   string
+	WasCorrect bool
+	IsCorrect  bool
+}
+
+// AttemptRegrade is a completed attempt whose result changes with the regraded answers.
+type AttemptRegrade struct {
+	QuizID          string
+	AttemptID       int
+	WasPass         bool
+	IsPass          bool
+	WasCorrectRatio float64
+	CorrectRatio    float64
+}
+
+type Regrade struct {
+	ParticipantID string
+	Answers       []AnswerRegrade
+	Attempts      []AttemptRegrade
+}
+
+func (r Regrade) IsEmpty() bool {
+	return len(r.Answers) == 0
+}
+
+// PlanRegrade compares the provided answers of every attempt of the course quizzes with the current answer key
+// without changing the participant. Answers to questions or answers that no longer exist are kept as they are.
+func (p *Participant) PlanRegrade(c course.Course) Regrade {
+	regrade := Regrade{
+		ParticipantID: p.id,
+	}
+
+	for _, quizID := range p.GetAttemptedQuizIDs() {
+		quiz, ok := c.FindQuiz(quizID)
+		if !ok {
+			continue
+		}
+
+		for i, quizAttempt := range p.quizAttempts[quizID] {
+			regradedAttempt := *quizAttempt
+			regradedAttempt.providedAnswers = make([]ProvidedAnswer, len(quizAttempt.providedAnswers))
+
+			regradedAnswerIDs := map[string]bool{}
+			for j, providedAnswer := range quizAttempt.providedAnswers {
+				regradedAttempt.providedAnswers[j] = providedAnswer
+
+				isCorrect, ok := isAnswerCorrect(quiz, providedAnswer.QuestionID, providedAnswer.AnswerID)
+				if !ok || isCorrect == providedAnswer.IsCorrect {
+					continue
+				}
+
+				regradedAttempt.providedAnswers[j].IsCorrect = isCorrect
+
+				answerKey := providedAnswer.QuestionID + "#" + providedAnswer.AnswerID
+				if regradedAnswerIDs[answerKey] {
+					continue
+				}
+				regradedAnswerIDs[answerKey] = true
+
+				regrade.Answers = append(regrade.Answers, AnswerRegrade{
+					QuizID:     quizID,
+					AttemptID:  i + 1,
+					QuestionID: providedAnswer.QuestionID,
+					AnswerID:   providedAnswer.AnswerID,
+					WasCorrect: providedAnswer.IsCorrect,
+					IsCorrect:  isCorrect,
+				})
+			}
+
+			if !quizAttempt.completed || len(regradedAnswerIDs) == 0 {
+				continue
+			}
+
+			wasCorrectRatio := quizAttempt.result().GetCorrectRatio()
+			correctRatio := regradedAttempt.result().GetCorrectRatio()
+			if wasCorrectRatio == correctRatio {
+				continue
+			}
+
+			regrade.Attempts = append(regrade.Attempts, AttemptRegrade{
+				QuizID:          quizID,
+				AttemptID:       i + 1,
+				WasPass:         quizAttempt.isPass(),
+				IsPass:          regradedAttempt.isPass(),
+				WasCorrectRatio: wasCorrectRatio,
+				CorrectRatio:    correctRatio,
+			})
+		}
+	}
+
+	return regrade
+}
+
+// RegradeAnswers applies the planned regrade of the course as AnswerRegraded events.
+func (p *Participant) RegradeAnswers(c course.Course) (Regrade, error) {
+	regrade := p.PlanRegrade(c)
+
+	for _, answerRegrade := range regrade.Answers {
+		answerRegradedEvent := event.AnswerRegraded{
+			QuizID:     answerRegrade.QuizID,
+			AttemptID:  answerRegrade.AttemptID,
+			QuestionID: answerRegrade.QuestionID,
+			AnswerID:   answerRegrade.AnswerID,
+			IsCorrect:  answerRegrade.IsCorrect,
+			EventBase:  p.createEventBaseEvent(),
+		}
+
+		err := p.apply(answerRegradedEvent, false)
+		if err != nil {
+			return Regrade{}, err
+		}
+	}
+
+	return regrade, nil
+}
+
+func (p *Participant) regradeAnswer(e event.AnswerRegraded) error {
+	quizAttempts := p.quizAttempts[e.QuizID]
+	if e.AttemptID < 1 || e.AttemptID > len(quizAttempts) {
+		return fmt.Errorf("can not regrade answer of attempt %d of quiz %v: attempt not found", e.AttemptID, e.QuizID)
+	}
+
+	quizAttempt := quizAttempts[e.AttemptID-1]
+
+	regraded := false
+	for i, providedAnswer := range quizAttempt.providedAnswers {
+		if providedAnswer.QuestionID == e.QuestionID && providedAnswer.AnswerID == e.AnswerID {
+			quizAttempt.providedAnswers[i].IsCorrect = e.IsCorrect
+			regraded = true
+		}
+	}
+
+	if !regraded {
+		return fmt.Errorf("can not regrade answer %v of question %v, it was not selected in attempt %d of quiz %v", e.AnswerID, e.QuestionID, e.AttemptID, e.QuizID)
+	}
+
+	return nil
+}
+
+func isAnswerCorrect(quiz course.StepQuiz, questionID string, answerID string) (bool, bool) {
+	question, ok := quiz.FindQuestion(questionID)
+	if !ok {
+		return false, false
+	}
+
+	answer, ok := question.FindAnswer(answerID)
+	if !ok {
+		return false, false
+	}
+
+	return answer.IsCorrect, true
+}
+
+// GetRegradedEvents returns the events of the participant with the regraded correctness in place of the correctness the
+// selected answers had when they were selected, projections read the answers from it instead of the stored events.
+func (p *Participant) GetRegradedEvents() []eventsource.Event {
+	events := p.GetEvents()
+
+	answerCorrections := map[string]bool{}
+	for _, generalEvent := range events {
+		if e, ok := generalEvent.(event.AnswerRegraded); ok {
+			answerCorrections[answerCorrectionKey(e.QuizID, e.AttemptID, e.QuestionID, e.AnswerID)] = e.IsCorrect
+		}
+	}
+
+	if len(answerCorrections) == 0 {
+		return events
+	}
+
+	regradedEvents := make([]eventsource.Event, len(events))
+	attemptCounts := map[string]int{}
+	for i, generalEvent := range events {
+		switch e := generalEvent.(type) {
+		case event.StartedQuiz:
+			attemptCounts[e.QuizID]++
+		case event.SelectedAnswer:
+			if isCorrect, ok := answerCorrections[answerCorrectionKey(e.QuizID, attemptCounts[e.QuizID], e.QuestionID, e.AnswerID)]; ok {
+				e.IsCorrect = isCorrect
+				generalEvent = e
+			}
+		}
+
+		regradedEvents[i] = generalEvent
+	}
+
+	return regradedEvents
+}
+
+func answerCorrectionKey(quizID string, attemptID int, questionID string, answerID string) string {
+	return fmt.Sprintf("%s#%d#%s#%s", quizID, attemptID, questionID, answerID)
+}
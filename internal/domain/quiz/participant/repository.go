@@ -16,4 +16,9 @@ type Repository interface {
 	FindOrCreateByID(participantID string) (Participant, error)
 
 	FindEventsByParticipantID(pariticipantID string) ([]eventsource.Event, error)
+
+	// FindParticipantIDPage returns up to limit ids of stored participants following the participant afterID, an empty
+	// afterID starts with the first participant. The next id continues with the following page and is empty after the
+	// last page. It scans the repository and is meant for rare maintenance tasks like regrading answers.
+	FindParticipantIDPage(afterID string, limit int) (participantIDs []string, nextID string, err error)
 }
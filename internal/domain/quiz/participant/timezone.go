@@ -0,0 +1,56 @@
+package participant
+
+import (
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"time"
+
+	// embeds the timezone database, the lambda runtime does not provide it
+	_ "time/tzdata"
+)
+
+var ErrInvalidTimezone = errors.New("timezone is not a valid IANA timezone")
+
+// DefaultTimezone is used until the participant set a timezone.
+const DefaultTimezone = "UTC"
+
+func (p *Participant) SetTimezone(timezone string) error {
+	timezoneSetEvent := event.TimezoneSet{
+		Timezone:  timezone,
+		EventBase: p.createEventBaseEvent(),
+	}
+
+	return p.apply(timezoneSetEvent, false)
+}
+
+func (p *Participant) GetTimezone() string {
+	if p.timezone == "" {
+		return DefaultTimezone
+	}
+
+	return p.timezone
+}
+
+// GetLocation returns the location of the timezone of the participant.
+func (p *Participant) GetLocation() *time.Location {
+	location, err := loadLocation(p.GetTimezone())
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+func loadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" || timezone == "Local" {
+		return nil, fmt.Errorf("timezone '%v': %w", timezone, ErrInvalidTimezone)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("timezone '%v': %w", timezone, ErrInvalidTimezone)
+	}
+
+	return location, nil
+}
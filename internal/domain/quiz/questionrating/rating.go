@@ -0,0 +1,34 @@
+package questionrating
+
+// Rating is the current rating of a question by a single participant.
+type Rating struct {
+	CourseID      string
+	QuestionID    string
+	ParticipantID string
+	Stars         int
+}
+
+// AggregatedRating is the average rating of a question across all participants.
+type AggregatedRating struct {
+	QuestionID string
+	Average    float64
+	Count      int
+}
+
+// Aggregate averages the ratings per question, questions without ratings are not contained.
+func Aggregate(ratings []Rating) map[string]AggregatedRating {
+	starSums := map[string]int{}
+	aggregatedRatings := map[string]AggregatedRating{}
+
+	for _, rating := range ratings {
+		starSums[rating.QuestionID] += rating.Stars
+
+		aggregatedRating := aggregatedRatings[rating.QuestionID]
+		aggregatedRating.QuestionID = rating.QuestionID
+		aggregatedRating.Count++
+		aggregatedRating.Average = float64(starSums[rating.QuestionID]) / float64(aggregatedRating.Count)
+		aggregatedRatings[rating.QuestionID] = aggregatedRating
+	}
+
+	return aggregatedRatings
+}
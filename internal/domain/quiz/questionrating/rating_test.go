@@ -0,0 +1,26 @@
+package questionrating_test
+
+import (
+	"learn-to-code/internal/domain/quiz/questionrating"
+	"testing"
+)
+
+func TestAggregate_AveragesRatingsPerQuestion(t *testing.T) {
+	aggregatedRatings := questionrating.Aggregate([]questionrating.Rating{
+		{CourseID: "course", QuestionID: "question-1", ParticipantID: "alice", Stars: 5},
+		{CourseID: "course", QuestionID: "question-1", ParticipantID: "bob", Stars: 2},
+		{CourseID: "course", QuestionID: "question-2", ParticipantID: "alice", Stars: 1},
+	})
+
+	if len(aggregatedRatings) != 2 {
+		t.Fatalf("expected ratings of 2 questions, got %v", aggregatedRatings)
+	}
+
+	if aggregatedRatings["question-1"] != (questionrating.AggregatedRating{QuestionID: "question-1", Average: 3.5, Count: 2}) {
+		t.Fatalf("expected average of 3.5 from 2 ratings, got %v", aggregatedRatings["question-1"])
+	}
+
+	if aggregatedRatings["question-2"] != (questionrating.AggregatedRating{QuestionID: "question-2", Average: 1, Count: 1}) {
+		t.Fatalf("expected average of 1 from 1 rating, got %v", aggregatedRatings["question-2"])
+	}
+}
@@ -0,0 +1,9 @@
+package questionrating
+
+type Repository interface {
+	// FindRatings returns the ratings of every participant for the questions of the course.
+	FindRatings(courseID string) ([]Rating, error)
+
+	// StoreRatings creates or replaces the ratings.
+	StoreRatings(ratings []Rating) error
+}
@@ -0,0 +1,212 @@
+package questionstats
+
+import (
+	"sort"
+	"time"
+)
+
+// RollingWindowSize is the number of latest answers per question and latest attempts per quiz the stats are
+// calculated from, so the stats follow changes of the questions.
+const RollingWindowSize = 1000
+
+// MinQuizAttemptCount is the number of attempts a quiz needs before its stats are meaningful.
+const MinQuizAttemptCount = 10
+
+// AnsweredQuestion is the final answer of a participant to a question in an ended attempt together with the time
+// spent on the question.
+type AnsweredQuestion struct {
+	CourseID      string
+	QuizID        string
+	QuestionID    string
+	ParticipantID string
+	AttemptID     int
+	TimeSpentSecs int
+	IsCorrect     bool
+
+	// EndedAt is the end of the attempt, answers stored before it was recorded have the zero time
+	EndedAt time.Time
+}
+
+// QuestionStats is the observed difficulty of a question across all participants within the rolling window.
+type QuestionStats struct {
+	QuestionID      string
+	AnswerCount     int
+	CorrectRatio    float64
+	AverageTimeSecs float64
+	MedianTimeSecs  float64
+	P90TimeSecs     float64
+}
+
+// QuizStats is the time per answered question in the attempts of a quiz across all participants within the rolling
+// window.
+type QuizStats struct {
+	QuizID                     string
+	AttemptCount               int
+	AverageTimePerQuestionSecs float64
+	MedianTimePerQuestionSecs  float64
+	P90TimePerQuestionSecs     float64
+}
+
+// CourseStats are the stats of every answered question and every attempted quiz of a course. The stats of a question
+// or quiz are refreshed when its attempts end or its answers are regraded, so reading them does not depend on the
+// number of answers.
+type CourseStats struct {
+	CourseID  string
+	Questions map[string]QuestionStats
+	Quizzes   map[string]QuizStats
+}
+
+// HasEnoughData tells if the stats are calculated from enough attempts with measured time to compare an attempt with.
+func (qs QuizStats) HasEnoughData() bool {
+	return qs.AttemptCount >= MinQuizAttemptCount && qs.AverageTimePerQuestionSecs > 0
+}
+
+// Aggregate calculates the stats per question, questions without answers are not contained.
+func Aggregate(answeredQuestions []AnsweredQuestion) map[string]QuestionStats {
+	answeredQuestionsPerQuestion := map[string][]AnsweredQuestion{}
+	for _, answeredQuestion := range answeredQuestions {
+		answeredQuestionsPerQuestion[answeredQuestion.QuestionID] = append(answeredQuestionsPerQuestion[answeredQuestion.QuestionID], answeredQuestion)
+	}
+
+	questionStats := map[string]QuestionStats{}
+	for questionID, questionAnswers := range answeredQuestionsPerQuestion {
+		sort.SliceStable(questionAnswers, func(i, j int) bool {
+			return questionAnswers[i].EndedAt.After(questionAnswers[j].EndedAt)
+		})
+		questionAnswers = questionAnswers[:min(len(questionAnswers), RollingWindowSize)]
+
+		correctAnswerCount := 0
+		var timesSpentSecs []float64
+		for _, questionAnswer := range questionAnswers {
+			if questionAnswer.IsCorrect {
+				correctAnswerCount++
+			}
+			timesSpentSecs = append(timesSpentSecs, float64(questionAnswer.TimeSpentSecs))
+		}
+
+		questionStats[questionID] = QuestionStats{
+			QuestionID:      questionID,
+			AnswerCount:     len(questionAnswers),
+			CorrectRatio:    float64(correctAnswerCount) / float64(len(questionAnswers)),
+			AverageTimeSecs: average(timesSpentSecs),
+			MedianTimeSecs:  percentile(timesSpentSecs, 50),
+			P90TimeSecs:     percentile(timesSpentSecs, 90),
+		}
+	}
+
+	return questionStats
+}
+
+// QuizAttempt is the time a participant spent on the answered questions of an ended attempt.
+type QuizAttempt struct {
+	CourseID      string
+	QuizID        string
+	ParticipantID string
+	AttemptID     int
+	AnswerCount   int
+	TimeSpentSecs int
+	EndedAt       time.Time
+}
+
+type attemptKey struct {
+	participantID string
+	quizID        string
+	attemptID     int
+}
+
+// NewQuizAttempts sums up the answered questions per attempt, the attempts are ordered by their first answered
+// question.
+func NewQuizAttempts(answeredQuestions []AnsweredQuestion) []QuizAttempt {
+	var quizAttempts []QuizAttempt
+	attemptIndexes := map[attemptKey]int{}
+	for _, answeredQuestion := range answeredQuestions {
+		key := attemptKey{
+			participantID: answeredQuestion.ParticipantID,
+			quizID:        answeredQuestion.QuizID,
+			attemptID:     answeredQuestion.AttemptID,
+		}
+		index, ok := attemptIndexes[key]
+		if !ok {
+			index = len(quizAttempts)
+			attemptIndexes[key] = index
+			quizAttempts = append(quizAttempts, QuizAttempt{
+				CourseID:      answeredQuestion.CourseID,
+				QuizID:        answeredQuestion.QuizID,
+				ParticipantID: answeredQuestion.ParticipantID,
+				AttemptID:     answeredQuestion.AttemptID,
+			})
+		}
+
+		quizAttempts[index].AnswerCount++
+		quizAttempts[index].TimeSpentSecs += answeredQuestion.TimeSpentSecs
+		if answeredQuestion.EndedAt.After(quizAttempts[index].EndedAt) {
+			quizAttempts[index].EndedAt = answeredQuestion.EndedAt
+		}
+	}
+
+	return quizAttempts
+}
+
+// AggregateQuizzes calculates the stats per quiz from the time per answered question of the attempts, quizzes
+// without attempts are not contained.
+func AggregateQuizzes(quizAttempts []QuizAttempt) map[string]QuizStats {
+	attemptsPerQuiz := map[string][]QuizAttempt{}
+	for _, attempt := range quizAttempts {
+		if attempt.AnswerCount == 0 {
+			continue
+		}
+		attemptsPerQuiz[attempt.QuizID] = append(attemptsPerQuiz[attempt.QuizID], attempt)
+	}
+
+	quizStats := map[string]QuizStats{}
+	for quizID, attempts := range attemptsPerQuiz {
+		sort.Slice(attempts, func(i, j int) bool {
+			if attempts[i].EndedAt.Equal(attempts[j].EndedAt) {
+				return attempts[i].TimeSpentSecs < attempts[j].TimeSpentSecs
+			}
+			return attempts[i].EndedAt.After(attempts[j].EndedAt)
+		})
+		attempts = attempts[:min(len(attempts), RollingWindowSize)]
+
+		var timesPerQuestionSecs []float64
+		for _, attempt := range attempts {
+			timesPerQuestionSecs = append(timesPerQuestionSecs, float64(attempt.TimeSpentSecs)/float64(attempt.AnswerCount))
+		}
+
+		quizStats[quizID] = QuizStats{
+			QuizID:                     quizID,
+			AttemptCount:               len(attempts),
+			AverageTimePerQuestionSecs: average(timesPerQuestionSecs),
+			MedianTimePerQuestionSecs:  percentile(timesPerQuestionSecs, 50),
+			P90TimePerQuestionSecs:     percentile(timesPerQuestionSecs, 90),
+		}
+	}
+
+	return quizStats
+}
+
+func average(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// percentile returns the nearest-rank percentile of the values, 0 without values.
+func percentile(values []float64, percent int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sortedValues := append([]float64{}, values...)
+	sort.Float64s(sortedValues)
+
+	rank := (percent*len(sortedValues) + 99) / 100
+	return sortedValues[max(rank, 1)-1]
+}
@@ -0,0 +1,77 @@
+package questionstats_test
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/questionstats"
+	"testing"
+	"time"
+)
+
+func TestAggregate_CalculatesCorrectRatioAndAverageTimePerQuestion(t *testing.T) {
+	questionStats := questionstats.Aggregate([]questionstats.AnsweredQuestion{
+		{CourseID: "course", QuestionID: "question-1", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 30, IsCorrect: true},
+		{CourseID: "course", QuestionID: "question-1", ParticipantID: "alice", AttemptID: 2, TimeSpentSecs: 10, IsCorrect: true},
+		{CourseID: "course", QuestionID: "question-1", ParticipantID: "bob", AttemptID: 1, TimeSpentSecs: 50, IsCorrect: false},
+		{CourseID: "course", QuestionID: "question-2", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 40, IsCorrect: false},
+	})
+
+	if len(questionStats) != 2 {
+		t.Fatalf("expected stats of 2 questions, got %v", questionStats)
+	}
+
+	if questionStats["question-1"].AnswerCount != 3 || questionStats["question-1"].AverageTimeSecs != 30 {
+		t.Fatalf("expected 3 answers with 30 seconds on average, got %v", questionStats["question-1"])
+	}
+
+	if questionStats["question-1"].MedianTimeSecs != 30 || questionStats["question-1"].P90TimeSecs != 50 {
+		t.Fatalf("expected a median of 30 and a 90th percentile of 50 seconds, got %v", questionStats["question-1"])
+	}
+
+	if questionStats["question-2"] != (questionstats.QuestionStats{QuestionID: "question-2", AnswerCount: 1, CorrectRatio: 0, AverageTimeSecs: 40, MedianTimeSecs: 40, P90TimeSecs: 40}) {
+		t.Fatalf("expected a single wrong answer after 40 seconds, got %v", questionStats["question-2"])
+	}
+}
+
+func TestAggregate_OnlyCountsLatestAnswersOfRollingWindow(t *testing.T) {
+	endedAt := time.Date(2023, 11, 6, 12, 0, 0, 0, time.UTC)
+
+	var answeredQuestions []questionstats.AnsweredQuestion
+	for i := 0; i < questionstats.RollingWindowSize; i++ {
+		answeredQuestions = append(answeredQuestions, questionstats.AnsweredQuestion{
+			QuestionID: "question", ParticipantID: fmt.Sprintf("participant-%d", i), AttemptID: 1, TimeSpentSecs: 20, IsCorrect: true, EndedAt: endedAt,
+		})
+	}
+	answeredQuestions = append(answeredQuestions, questionstats.AnsweredQuestion{
+		QuestionID: "question", ParticipantID: "early-participant", AttemptID: 1, TimeSpentSecs: 500, IsCorrect: false, EndedAt: endedAt.AddDate(0, 0, -1),
+	})
+
+	questionStats := questionstats.Aggregate(answeredQuestions)["question"]
+
+	if questionStats.AnswerCount != questionstats.RollingWindowSize || questionStats.CorrectRatio != 1 || questionStats.AverageTimeSecs != 20 {
+		t.Fatalf("expected the earliest answer to drop out of the rolling window, got %v", questionStats)
+	}
+}
+
+func TestAggregateQuizzes_CalculatesTimePerQuestionOfAttempts(t *testing.T) {
+	quizStats := questionstats.AggregateQuizzes(questionstats.NewQuizAttempts([]questionstats.AnsweredQuestion{
+		{QuizID: "quiz", QuestionID: "question-1", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 10},
+		{QuizID: "quiz", QuestionID: "question-2", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 30},
+		{QuizID: "quiz", QuestionID: "question-1", ParticipantID: "alice", AttemptID: 2, TimeSpentSecs: 40},
+		{QuizID: "quiz", QuestionID: "question-1", ParticipantID: "bob", AttemptID: 1, TimeSpentSecs: 90},
+	}))["quiz"]
+
+	expectedQuizStats := questionstats.QuizStats{
+		QuizID:                     "quiz",
+		AttemptCount:               3,
+		AverageTimePerQuestionSecs: 50,
+		MedianTimePerQuestionSecs:  40,
+		P90TimePerQuestionSecs:     90,
+	}
+	if quizStats != expectedQuizStats {
+		t.Fatalf("expected %v, got %v", expectedQuizStats, quizStats)
+	}
+
+	if quizStats.HasEnoughData() {
+		t.Fatalf("expected %d attempts not to be enough data", quizStats.AttemptCount)
+	}
+}
@@ -0,0 +1,22 @@
+package questionstats
+
+type Repository interface {
+	// FindLatestAnsweredQuestions returns the latest answered questions of the question within the rolling window.
+	FindLatestAnsweredQuestions(courseID string, questionID string) ([]AnsweredQuestion, error)
+
+	// StoreAnsweredQuestions creates or replaces the answered questions.
+	StoreAnsweredQuestions(answeredQuestions []AnsweredQuestion) error
+
+	// FindLatestQuizAttempts returns the latest attempts of the quiz within the rolling window.
+	FindLatestQuizAttempts(courseID string, quizID string) ([]QuizAttempt, error)
+
+	// StoreQuizAttempts creates or replaces the quiz attempts.
+	StoreQuizAttempts(quizAttempts []QuizAttempt) error
+
+	// FindCourseStats returns the stored stats of the course, courses without stats have empty stats.
+	FindCourseStats(courseID string) (CourseStats, error)
+
+	// StoreCourseStats creates or replaces the contained stats of questions and quizzes, the stats of the other
+	// questions and quizzes of the course are kept.
+	StoreCourseStats(courseStats CourseStats) error
+}
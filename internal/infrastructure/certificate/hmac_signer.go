@@ -0,0 +1,34 @@
+package certificate
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/participant"
+	"time"
+)
+
+type HmacSigner struct {
+	secret string
+}
+
+func NewHmacSigner(secret string) *HmacSigner {
+	return &HmacSigner{
+		secret: secret,
+	}
+}
+
+// Sign creates a HMAC-SHA256 signature of every certificate field except the signature itself.
+func (s HmacSigner) Sign(c participant.Certificate) string {
+	if s.secret == "" {
+		panic("no certificate secret")
+	}
+
+	payload := fmt.Sprintf("%s|%s|%s|%s", c.ID, c.ParticipantID, c.CourseID, c.CompletedAt.UTC().Format(time.RFC3339))
+
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
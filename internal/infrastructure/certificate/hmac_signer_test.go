@@ -0,0 +1,46 @@
+package certificate_test
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/certificate"
+	"testing"
+	"time"
+)
+
+func TestHmacSigner_Sign_IsDeterministic(t *testing.T) {
+	signer := certificate.NewHmacSigner("secret")
+	c := createCertificate()
+
+	if signer.Sign(c) != signer.Sign(c) {
+		t.Fatalf("expected the same signature for the same certificate")
+	}
+}
+
+func TestHmacSigner_Sign_ChangesWithCertificateFields(t *testing.T) {
+	signer := certificate.NewHmacSigner("secret")
+	c := createCertificate()
+
+	otherCourse := c
+	otherCourse.CourseID = "other-course"
+
+	if signer.Sign(c) == signer.Sign(otherCourse) {
+		t.Fatalf("expected a different signature for a different course")
+	}
+}
+
+func TestHmacSigner_Sign_ChangesWithSecret(t *testing.T) {
+	c := createCertificate()
+
+	if certificate.NewHmacSigner("secret").Sign(c) == certificate.NewHmacSigner("other-secret").Sign(c) {
+		t.Fatalf("expected a different signature for a different secret")
+	}
+}
+
+func createCertificate() participant.Certificate {
+	return participant.Certificate{
+		ID:            participant.NewCertificateID("participant"),
+		ParticipantID: "participant",
+		CourseID:      "course",
+		CompletedAt:   time.Date(2023, 11, 3, 10, 0, 0, 0, time.UTC),
+	}
+}
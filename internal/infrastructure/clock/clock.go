@@ -0,0 +1,38 @@
+package clock
+
+import "time"
+
+// Clock provides the current time, so time dependent behavior can be tested with a fixed time.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct {
+}
+
+func NewSystemClock() *SystemClock {
+	return &SystemClock{}
+}
+
+func (c *SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type FixedClock struct {
+	now time.Time
+}
+
+func NewFixedClock(now time.Time) *FixedClock {
+	return &FixedClock{
+		now: now,
+	}
+}
+
+func (c *FixedClock) Now() time.Time {
+	return c.now
+}
+
+// Set moves the fixed time, so tests can let time pass between two actions.
+func (c *FixedClock) Set(now time.Time) {
+	c.now = now
+}
@@ -3,18 +3,28 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 )
 
 type Config struct {
-	Environment      Environment
-	DefaultAwsRegion string
-	JwtSecret        string
-	CorsAllowOrigin  string
+	Environment       Environment
+	DefaultAwsRegion  string
+	JwtSecret         string
+	CorsAllowOrigin   string
+	CertificateSecret string
+
+	// AdminParticipantIDs are allowed to moderate, e.g. resolve reported question issues.
+	AdminParticipantIDs []string
 }
 
 const EnvEnvironmentKey = "ENVIRONMENT"
 const EnvJwtSecretKey = "JWT_SECRET"
 const EnvCorsAllowOriginKey = "CORS_ALLOW_ORIGIN_URL"
+const EnvCertificateSecretKey = "CERTIFICATE_SECRET"
+const EnvAdminParticipantIDsKey = "ADMIN_PARTICIPANT_IDS"
+
+// TestCertificateSecret is the well known secret of the tests, certificates signed with it could be forged by anyone.
+const TestCertificateSecret = "test"
 
 func NewConfig() (Config, error) {
 
@@ -33,10 +43,29 @@ func NewConfig() (Config, error) {
 		return Config{}, fmt.Errorf("missing environment variable '%s'", EnvCorsAllowOriginKey)
 	}
 
+	certificateSecret := os.Getenv(EnvCertificateSecretKey)
+	if certificateSecret == "" {
+		return Config{}, fmt.Errorf("missing environment variable '%s'", EnvCertificateSecretKey)
+	}
+
+	if certificateSecret == TestCertificateSecret && environment != Test {
+		return Config{}, fmt.Errorf("environment variable '%s' must not be the test secret in environment '%s'", EnvCertificateSecretKey, environment)
+	}
+
+	// optional, without admins nobody can moderate
+	var adminParticipantIDs []string
+	for _, adminParticipantID := range strings.Split(os.Getenv(EnvAdminParticipantIDsKey), ",") {
+		if strings.TrimSpace(adminParticipantID) != "" {
+			adminParticipantIDs = append(adminParticipantIDs, strings.TrimSpace(adminParticipantID))
+		}
+	}
+
 	return Config{
-		Environment:      environment,
-		DefaultAwsRegion: "eu-central-1",
-		JwtSecret:        jwtSecret,
-		CorsAllowOrigin:  allowOrigin,
+		Environment:         environment,
+		DefaultAwsRegion:    "eu-central-1",
+		JwtSecret:           jwtSecret,
+		CorsAllowOrigin:     allowOrigin,
+		CertificateSecret:   certificateSecret,
+		AdminParticipantIDs: adminParticipantIDs,
 	}, err
 }
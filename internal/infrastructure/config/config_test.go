@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestNewConfig_TestCertificateSecretOutsideOfTests_Fails(t *testing.T) {
+	t.Setenv(EnvEnvironmentKey, string(Prod))
+	t.Setenv(EnvJwtSecretKey, "jwt-secret")
+	t.Setenv(EnvCorsAllowOriginKey, "http://localhost:3000")
+	t.Setenv(EnvCertificateSecretKey, TestCertificateSecret)
+
+	_, err := NewConfig()
+
+	if err == nil {
+		t.Fatalf("expected the test certificate secret to be rejected in prod")
+	}
+}
+
+func TestNewConfig_TestCertificateSecretInTests_Succeeds(t *testing.T) {
+	t.Setenv(EnvEnvironmentKey, string(Test))
+	t.Setenv(EnvJwtSecretKey, "jwt-secret")
+	t.Setenv(EnvCorsAllowOriginKey, "http://localhost:3000")
+	t.Setenv(EnvCertificateSecretKey, TestCertificateSecret)
+
+	_, err := NewConfig()
+
+	if err != nil {
+		t.Fatalf("expected the test certificate secret to be accepted in tests, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"learn-to-code/internal/domain/eventsource"
+	moderationevent "learn-to-code/internal/domain/quiz/moderation/event"
 	"learn-to-code/internal/domain/quiz/participant/event"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
@@ -60,6 +61,71 @@ func (r EventPODeserializer) outputItemToEvent(outputItem map[string]types.Attri
 		deserializeError = r.deserializer([]byte(eventPo.Payload), finishedQuiz)
 
 		deserializedEvent = *finishedQuiz
+	case event.QuizTimedOutTypeName:
+		quizTimedOut := &event.QuizTimedOut{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), quizTimedOut)
+		deserializedEvent = *quizTimedOut
+	case event.ReviewedQuestionTypeName:
+		reviewedQuestion := &event.ReviewedQuestion{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), reviewedQuestion)
+		deserializedEvent = *reviewedQuestion
+	case event.CourseCompletedTypeName:
+		courseCompleted := &event.CourseCompleted{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), courseCompleted)
+		deserializedEvent = *courseCompleted
+	case event.BadgeAwardedTypeName:
+		badgeAwarded := &event.BadgeAwarded{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), badgeAwarded)
+		deserializedEvent = *badgeAwarded
+	case event.TimezoneSetTypeName:
+		timezoneSet := &event.TimezoneSet{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), timezoneSet)
+		deserializedEvent = *timezoneSet
+
+	case event.LeaderboardOptOutSetTypeName:
+		leaderboardOptOutSet := &event.LeaderboardOptOutSet{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), leaderboardOptOutSet)
+		deserializedEvent = *leaderboardOptOutSet
+
+	case event.AnswerRegradedTypeName:
+		answerRegraded := &event.AnswerRegraded{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), answerRegraded)
+		deserializedEvent = *answerRegraded
+
+	case event.HintRequestedTypeName:
+		hintRequested := &event.HintRequested{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), hintRequested)
+		deserializedEvent = *hintRequested
+
+	case event.QuestionViewedTypeName:
+		questionViewed := &event.QuestionViewed{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionViewed)
+		deserializedEvent = *questionViewed
+
+	case event.QuestionRatedTypeName:
+		questionRated := &event.QuestionRated{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionRated)
+		deserializedEvent = *questionRated
+
+	case event.QuestionIssueReportedTypeName:
+		questionIssueReported := &event.QuestionIssueReported{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionIssueReported)
+		deserializedEvent = *questionIssueReported
+
+	case moderationevent.QuestionIssueQueuedTypeName:
+		questionIssueQueued := &moderationevent.QuestionIssueQueued{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionIssueQueued)
+		deserializedEvent = *questionIssueQueued
+
+	case moderationevent.QuestionIssueResolvedTypeName:
+		questionIssueResolved := &moderationevent.QuestionIssueResolved{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionIssueResolved)
+		deserializedEvent = *questionIssueResolved
+
+	case moderationevent.QuestionIssueRejectedTypeName:
+		questionIssueRejected := &moderationevent.QuestionIssueRejected{}
+		deserializeError = r.deserializer([]byte(eventPo.Payload), questionIssueRejected)
+		deserializedEvent = *questionIssueRejected
 
 	default:
 		panic(fmt.Errorf("unknown type '%s' while reading persisted events", eventPo.Type))
@@ -0,0 +1,143 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/infrastructure/config"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// LeaderboardRepository stores the entries of a leaderboard in one partition per course and period, so a whole
+// leaderboard is read with a single query.
+type LeaderboardRepository struct {
+	dbClient  *dynamodb.Client
+	ctx       context.Context
+	tableName string
+}
+
+func NewDynamoDbLeaderboardRepository(ctx context.Context, environment config.Environment, client *dynamodb.Client) *LeaderboardRepository {
+
+	tableName := fmt.Sprintf("%s_leaderboard", environment)
+
+	return &LeaderboardRepository{
+		dbClient:  client,
+		ctx:       ctx,
+		tableName: tableName,
+	}
+}
+
+func (r *LeaderboardRepository) FindEntries(courseID string, period string) ([]leaderboard.Entry, error) {
+	var entries []leaderboard.Entry
+	var exclusiveStartKey map[string]types.AttributeValue
+
+	for {
+		output, err := r.dbClient.Query(r.ctx, &dynamodb.QueryInput{
+			TableName: &r.tableName,
+			KeyConditions: map[string]types.Condition{
+				"leaderboard_id": {
+					ComparisonOperator: types.ComparisonOperatorEq,
+					AttributeValueList: []types.AttributeValue{
+						&types.AttributeValueMemberS{Value: getLeaderboardID(courseID, period)},
+					},
+				},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, outputItem := range output.Items {
+			entry, err := outputItemToEntry(courseID, period, outputItem)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, entry)
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return entries, nil
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func (r *LeaderboardRepository) StoreEntries(entries []leaderboard.Entry) error {
+	for _, entry := range entries {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.tableName,
+			Item: map[string]types.AttributeValue{
+				"leaderboard_id": &types.AttributeValueMemberS{Value: getLeaderboardID(entry.CourseID, entry.Period)},
+				"participant_id": &types.AttributeValueMemberS{Value: entry.ParticipantID},
+				"xp":             &types.AttributeValueMemberN{Value: strconv.Itoa(entry.XP)},
+				"quizzes_passed": &types.AttributeValueMemberN{Value: strconv.Itoa(entry.QuizzesPassed)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *LeaderboardRepository) DeleteEntries(entries []leaderboard.Entry) error {
+	for _, entry := range entries {
+		_, err := r.dbClient.DeleteItem(r.ctx, &dynamodb.DeleteItemInput{
+			TableName: &r.tableName,
+			Key: map[string]types.AttributeValue{
+				"leaderboard_id": &types.AttributeValueMemberS{Value: getLeaderboardID(entry.CourseID, entry.Period)},
+				"participant_id": &types.AttributeValueMemberS{Value: entry.ParticipantID},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getLeaderboardID(courseID string, period string) string {
+	return courseID + "#" + period
+}
+
+func outputItemToEntry(courseID string, period string, outputItem map[string]types.AttributeValue) (leaderboard.Entry, error) {
+	participantID, ok := outputItem["participant_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return leaderboard.Entry{}, fmt.Errorf("leaderboard entry of %v without participant id", getLeaderboardID(courseID, period))
+	}
+
+	xp, err := getNumberAttribute(outputItem, "xp")
+	if err != nil {
+		return leaderboard.Entry{}, err
+	}
+
+	quizzesPassed, err := getNumberAttribute(outputItem, "quizzes_passed")
+	if err != nil {
+		return leaderboard.Entry{}, err
+	}
+
+	return leaderboard.Entry{
+		CourseID:      courseID,
+		Period:        period,
+		ParticipantID: participantID.Value,
+		XP:            xp,
+		QuizzesPassed: quizzesPassed,
+	}, nil
+}
+
+func getNumberAttribute(outputItem map[string]types.AttributeValue, name string) (int, error) {
+	attribute, ok := outputItem[name].(*types.AttributeValueMemberN)
+	if !ok {
+		return 0, fmt.Errorf("attribute %v is missing or not a number", name)
+	}
+
+	return strconv.Atoi(attribute.Value)
+}
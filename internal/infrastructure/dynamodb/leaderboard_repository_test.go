@@ -0,0 +1,58 @@
+package dynamodb_test
+
+import (
+	"context"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	dynamodb "learn-to-code/internal/infrastructure/dynamodb"
+	errUtils "learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/db"
+	"testing"
+)
+
+func TestLeaderboardRepository_StoreEntries_ReplacesEntriesOfLeaderboard(t *testing.T) {
+	repo, clean := getLeaderboardRepository()
+	defer clean()
+
+	errUtils.PanicIfError(repo.StoreEntries([]leaderboard.Entry{
+		{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: "alice", XP: 10, QuizzesPassed: 1},
+		{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: "bob", XP: 20, QuizzesPassed: 2},
+		{CourseID: "course", Period: "2023-W45", ParticipantID: "alice", XP: 10, QuizzesPassed: 1},
+	}))
+	errUtils.PanicIfError(repo.StoreEntries([]leaderboard.Entry{
+		{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: "alice", XP: 30, QuizzesPassed: 2},
+	}))
+
+	entries := errUtils.PanicIfError1(repo.FindEntries("course", leaderboard.AllTimePeriod))
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries in the all-time leaderboard, got %v", entries)
+	}
+
+	if entries[0] != (leaderboard.Entry{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: "alice", XP: 30, QuizzesPassed: 2}) {
+		t.Fatalf("expected the replaced entry of alice, got %v", entries[0])
+	}
+}
+
+func TestLeaderboardRepository_DeleteEntries_RemovesEntries(t *testing.T) {
+	repo, clean := getLeaderboardRepository()
+	defer clean()
+
+	entries := []leaderboard.Entry{
+		{CourseID: "course", Period: leaderboard.AllTimePeriod, ParticipantID: "alice", XP: 10, QuizzesPassed: 1},
+	}
+
+	errUtils.PanicIfError(repo.StoreEntries(entries))
+	errUtils.PanicIfError(repo.DeleteEntries(entries))
+
+	if len(errUtils.PanicIfError1(repo.FindEntries("course", leaderboard.AllTimePeriod))) != 0 {
+		t.Fatalf("expected no entries after deleting them")
+	}
+}
+
+func getLeaderboardRepository() (leaderboard.Repository, func()) {
+	dynamoDbClient, clean := db.StartDynamoDB()
+
+	repo := dynamodb.NewDynamoDbLeaderboardRepository(context.Background(), "test", dynamoDbClient)
+
+	return repo, clean
+}
@@ -0,0 +1,101 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"learn-to-code/internal/domain/eventsource"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/infrastructure/config"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ModerationQueueRepository stores the events of the moderation queue next to the participant events, the queue
+// is a single aggregate with the id moderation.QueueID.
+type ModerationQueueRepository struct {
+	dbClient            *dynamodb.Client
+	eventPODeserializer *EventPODeserializer
+	ctx                 context.Context
+	serializer          MarshalFunc
+	tableName           string
+}
+
+func NewDynamoDbModerationQueueRepository(ctx context.Context, environment config.Environment, client *dynamodb.Client, eventPODeserializer *EventPODeserializer) *ModerationQueueRepository {
+
+	tableName := fmt.Sprintf("%s_events", environment)
+
+	return &ModerationQueueRepository{
+		dbClient:            client,
+		ctx:                 ctx,
+		serializer:          json.Marshal,
+		tableName:           tableName,
+		eventPODeserializer: eventPODeserializer,
+	}
+}
+
+func (r *ModerationQueueRepository) FindOrCreate() (moderation.Queue, error) {
+	var events []eventsource.Event
+	var exclusiveStartKey map[string]types.AttributeValue
+
+	for {
+		output, err := r.dbClient.Query(r.ctx, &dynamodb.QueryInput{
+			TableName: &r.tableName,
+			KeyConditions: map[string]types.Condition{
+				"aggregate_id": {
+					ComparisonOperator: types.ComparisonOperatorEq,
+					AttributeValueList: []types.AttributeValue{
+						&types.AttributeValueMemberS{Value: moderation.QueueID},
+					},
+				},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return moderation.Queue{}, err
+		}
+
+		for _, outputItem := range output.Items {
+			deserializedEvent, err := r.eventPODeserializer.outputItemToEvent(outputItem)
+			if err != nil {
+				return moderation.Queue{}, err
+			}
+
+			events = append(events, deserializedEvent)
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return moderation.NewQueueFromEvents(events, true)
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func (r *ModerationQueueRepository) StoreEvents(events []eventsource.Event) error {
+	for _, e := range events {
+		serializedEvent, err := r.serializer(e)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.tableName,
+			Item: map[string]types.AttributeValue{
+				"aggregate_id": &types.AttributeValueMemberS{Value: moderation.QueueID},
+				"version":      &types.AttributeValueMemberN{Value: fmt.Sprintf("%d", e.GetVersion())},
+				"type":         &types.AttributeValueMemberS{Value: reflect.TypeOf(e).Name()},
+				"payload":      &types.AttributeValueMemberS{Value: string(serializedEvent)},
+				"created_at":   &types.AttributeValueMemberS{Value: e.GetCreatedAt().Format(time.RFC3339)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"learn-to-code/internal/domain/eventsource"
 	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/domain/quiz/participant/event"
 	"learn-to-code/internal/infrastructure/config"
 	"reflect"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
@@ -71,6 +73,60 @@ func (r *ParticipantRepository) FindOrCreateByID(id string) (participant.Partici
 	return p, newFromEventsErr
 }
 
+func (r *ParticipantRepository) FindParticipantIDPage(afterID string, limit int) ([]string, string, error) {
+	var participantIDs []string
+
+	// the participant is created with the first event, so its key continues the scan after the participant
+	var exclusiveStartKey map[string]types.AttributeValue
+	if afterID != "" {
+		exclusiveStartKey = map[string]types.AttributeValue{
+			"aggregate_id": &types.AttributeValueMemberS{Value: afterID},
+			"version":      &types.AttributeValueMemberN{Value: "0"},
+		}
+	}
+
+	for {
+		output, err := r.dbClient.Scan(r.ctx, &dynamodb.ScanInput{
+			TableName:            &r.tableName,
+			ProjectionExpression: aws.String("aggregate_id"),
+			FilterExpression:     aws.String("#type = :type"),
+			ExpressionAttributeNames: map[string]string{
+				"#type": "type",
+			},
+			ExpressionAttributeValues: map[string]types.AttributeValue{
+				":type": &types.AttributeValueMemberS{Value: event.ParticipantCreatedTypeName},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		for i, outputItem := range output.Items {
+			aggregateID, ok := outputItem["aggregate_id"].(*types.AttributeValueMemberS)
+			if !ok {
+				return nil, "", fmt.Errorf("aggregate_id of participant is not a string")
+			}
+
+			participantIDs = append(participantIDs, aggregateID.Value)
+
+			if len(participantIDs) == limit {
+				if i == len(output.Items)-1 && len(output.LastEvaluatedKey) == 0 {
+					return participantIDs, "", nil
+				}
+
+				return participantIDs, aggregateID.Value, nil
+			}
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return participantIDs, "", nil
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
 func (r *ParticipantRepository) StoreEvents(participantID string, events []eventsource.Event) error {
 	for _, e := range events {
 		err := r.appendEvent(participantID, e)
@@ -92,6 +92,41 @@ func TestParticipantRepository_StoreEventsWithPayload(t *testing.T) {
 	}
 }
 
+func TestParticipantRepository_FindParticipantIDPage_ReturnsStoredParticipantsInPages(t *testing.T) {
+	repo, clean := getRepository()
+	defer clean()
+
+	storedParticipantIDs := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		p := errUtils.PanicIfError1(participant.New())
+		errUtils.PanicIfError(p.StartQuiz(uuid.MustNewRandomAsString(), nil))
+		errUtils.PanicIfError(
+			repo.StoreEvents(p.GetID(), p.GetNewEventsAndUpdatePersistedVersion()),
+		)
+		storedParticipantIDs[p.GetID()] = true
+	}
+
+	firstPage, nextID, err := repo.FindParticipantIDPage("", 2)
+	errUtils.PanicIfError(err)
+	if len(firstPage) != 2 || nextID != firstPage[1] {
+		t.Fatalf("expected a first page of 2 participants continuing after the last one, got %v and %s", firstPage, nextID)
+	}
+
+	secondPage, nextID, err := repo.FindParticipantIDPage(nextID, 2)
+	errUtils.PanicIfError(err)
+	if len(secondPage) != 1 || nextID != "" {
+		t.Fatalf("expected the last participant on the last page, got %v and %s", secondPage, nextID)
+	}
+
+	for _, participantID := range append(firstPage, secondPage...) {
+		delete(storedParticipantIDs, participantID)
+	}
+
+	if len(storedParticipantIDs) != 0 {
+		t.Fatalf("expected every stored participant on a page, missing %v", storedParticipantIDs)
+	}
+}
+
 func getRepository() (participant.Repository, func()) {
 	dynamoDbClient, clean := db.StartDynamoDB()
 
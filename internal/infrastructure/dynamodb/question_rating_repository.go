@@ -0,0 +1,117 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/questionrating"
+	"learn-to-code/internal/infrastructure/config"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// QuestionRatingRepository stores the ratings of all participants in one partition per course, so the ratings of
+// every question of a course are read with a single query.
+type QuestionRatingRepository struct {
+	dbClient  *dynamodb.Client
+	ctx       context.Context
+	tableName string
+}
+
+func NewDynamoDbQuestionRatingRepository(ctx context.Context, environment config.Environment, client *dynamodb.Client) *QuestionRatingRepository {
+
+	tableName := fmt.Sprintf("%s_question_rating", environment)
+
+	return &QuestionRatingRepository{
+		dbClient:  client,
+		ctx:       ctx,
+		tableName: tableName,
+	}
+}
+
+func (r *QuestionRatingRepository) FindRatings(courseID string) ([]questionrating.Rating, error) {
+	var ratings []questionrating.Rating
+	var exclusiveStartKey map[string]types.AttributeValue
+
+	for {
+		output, err := r.dbClient.Query(r.ctx, &dynamodb.QueryInput{
+			TableName: &r.tableName,
+			KeyConditions: map[string]types.Condition{
+				"course_id": {
+					ComparisonOperator: types.ComparisonOperatorEq,
+					AttributeValueList: []types.AttributeValue{
+						&types.AttributeValueMemberS{Value: courseID},
+					},
+				},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, outputItem := range output.Items {
+			rating, err := outputItemToRating(courseID, outputItem)
+			if err != nil {
+				return nil, err
+			}
+
+			ratings = append(ratings, rating)
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return ratings, nil
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func (r *QuestionRatingRepository) StoreRatings(ratings []questionrating.Rating) error {
+	for _, rating := range ratings {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.tableName,
+			Item: map[string]types.AttributeValue{
+				"course_id":      &types.AttributeValueMemberS{Value: rating.CourseID},
+				"rating_id":      &types.AttributeValueMemberS{Value: getRatingID(rating.QuestionID, rating.ParticipantID)},
+				"question_id":    &types.AttributeValueMemberS{Value: rating.QuestionID},
+				"participant_id": &types.AttributeValueMemberS{Value: rating.ParticipantID},
+				"stars":          &types.AttributeValueMemberN{Value: strconv.Itoa(rating.Stars)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getRatingID(questionID string, participantID string) string {
+	return questionID + "#" + participantID
+}
+
+func outputItemToRating(courseID string, outputItem map[string]types.AttributeValue) (questionrating.Rating, error) {
+	questionID, ok := outputItem["question_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionrating.Rating{}, fmt.Errorf("question rating of course %v without question id", courseID)
+	}
+
+	participantID, ok := outputItem["participant_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionrating.Rating{}, fmt.Errorf("question rating of course %v without participant id", courseID)
+	}
+
+	stars, err := getNumberAttribute(outputItem, "stars")
+	if err != nil {
+		return questionrating.Rating{}, err
+	}
+
+	return questionrating.Rating{
+		CourseID:      courseID,
+		QuestionID:    questionID.Value,
+		ParticipantID: participantID.Value,
+		Stars:         stars,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package dynamodb_test
+
+import (
+	"context"
+	"learn-to-code/internal/domain/quiz/questionrating"
+	dynamodb "learn-to-code/internal/infrastructure/dynamodb"
+	errUtils "learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/db"
+	"testing"
+)
+
+func TestQuestionRatingRepository_StoreRatings_ReplacesRatingOfParticipant(t *testing.T) {
+	repo, clean := getQuestionRatingRepository()
+	defer clean()
+
+	errUtils.PanicIfError(repo.StoreRatings([]questionrating.Rating{
+		{CourseID: "course", QuestionID: "question", ParticipantID: "alice", Stars: 1},
+		{CourseID: "course", QuestionID: "question", ParticipantID: "bob", Stars: 4},
+		{CourseID: "other-course", QuestionID: "other-question", ParticipantID: "alice", Stars: 5},
+	}))
+	errUtils.PanicIfError(repo.StoreRatings([]questionrating.Rating{
+		{CourseID: "course", QuestionID: "question", ParticipantID: "alice", Stars: 3},
+	}))
+
+	ratings := errUtils.PanicIfError1(repo.FindRatings("course"))
+
+	if len(ratings) != 2 {
+		t.Fatalf("expected 2 ratings of the course, got %v", ratings)
+	}
+
+	if ratings[0] != (questionrating.Rating{CourseID: "course", QuestionID: "question", ParticipantID: "alice", Stars: 3}) {
+		t.Fatalf("expected the replaced rating of alice, got %v", ratings[0])
+	}
+}
+
+func getQuestionRatingRepository() (questionrating.Repository, func()) {
+	dynamoDbClient, clean := db.StartDynamoDB()
+
+	repo := dynamodb.NewDynamoDbQuestionRatingRepository(context.Background(), "test", dynamoDbClient)
+
+	return repo, clean
+}
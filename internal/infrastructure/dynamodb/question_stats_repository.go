@@ -0,0 +1,414 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/questionstats"
+	"learn-to-code/internal/infrastructure/config"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// QuestionStatsRepository stores the answered questions and quiz attempts of all participants in one partition per
+// course. The window index sorts them by their end per question and per quiz, so only the latest ones within the
+// rolling window are read. The stats aggregated from them are stored in a second table with one item per question and
+// quiz of the course.
+type QuestionStatsRepository struct {
+	dbClient             *dynamodb.Client
+	ctx                  context.Context
+	tableName            string
+	courseStatsTableName string
+}
+
+func NewDynamoDbQuestionStatsRepository(ctx context.Context, environment config.Environment, client *dynamodb.Client) *QuestionStatsRepository {
+
+	tableName := fmt.Sprintf("%s_question_stats", environment)
+	courseStatsTableName := fmt.Sprintf("%s_course_stats", environment)
+
+	return &QuestionStatsRepository{
+		dbClient:             client,
+		ctx:                  ctx,
+		tableName:            tableName,
+		courseStatsTableName: courseStatsTableName,
+	}
+}
+
+func (r *QuestionStatsRepository) FindLatestAnsweredQuestions(courseID string, questionID string) ([]questionstats.AnsweredQuestion, error) {
+	outputItems, err := r.findLatestItems(getWindowID(courseID, questionStatsIDPrefix, questionID))
+	if err != nil {
+		return nil, err
+	}
+
+	var answeredQuestions []questionstats.AnsweredQuestion
+	for _, outputItem := range outputItems {
+		answeredQuestion, err := outputItemToAnsweredQuestion(courseID, outputItem)
+		if err != nil {
+			return nil, err
+		}
+
+		answeredQuestions = append(answeredQuestions, answeredQuestion)
+	}
+
+	return answeredQuestions, nil
+}
+
+func (r *QuestionStatsRepository) StoreAnsweredQuestions(answeredQuestions []questionstats.AnsweredQuestion) error {
+	for _, answeredQuestion := range answeredQuestions {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.tableName,
+			Item: map[string]types.AttributeValue{
+				"course_id":       &types.AttributeValueMemberS{Value: answeredQuestion.CourseID},
+				"answer_id":       &types.AttributeValueMemberS{Value: getAnswerID(answeredQuestion)},
+				"window_id":       &types.AttributeValueMemberS{Value: getWindowID(answeredQuestion.CourseID, questionStatsIDPrefix, answeredQuestion.QuestionID)},
+				"quiz_id":         &types.AttributeValueMemberS{Value: answeredQuestion.QuizID},
+				"question_id":     &types.AttributeValueMemberS{Value: answeredQuestion.QuestionID},
+				"participant_id":  &types.AttributeValueMemberS{Value: answeredQuestion.ParticipantID},
+				"attempt_id":      &types.AttributeValueMemberN{Value: strconv.Itoa(answeredQuestion.AttemptID)},
+				"time_spent_secs": &types.AttributeValueMemberN{Value: strconv.Itoa(answeredQuestion.TimeSpentSecs)},
+				"is_correct":      &types.AttributeValueMemberBOOL{Value: answeredQuestion.IsCorrect},
+				"ended_at":        &types.AttributeValueMemberS{Value: formatEndedAt(answeredQuestion.EndedAt)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *QuestionStatsRepository) FindLatestQuizAttempts(courseID string, quizID string) ([]questionstats.QuizAttempt, error) {
+	outputItems, err := r.findLatestItems(getWindowID(courseID, quizStatsIDPrefix, quizID))
+	if err != nil {
+		return nil, err
+	}
+
+	var quizAttempts []questionstats.QuizAttempt
+	for _, outputItem := range outputItems {
+		quizAttempt, err := outputItemToQuizAttempt(courseID, outputItem)
+		if err != nil {
+			return nil, err
+		}
+
+		quizAttempts = append(quizAttempts, quizAttempt)
+	}
+
+	return quizAttempts, nil
+}
+
+func (r *QuestionStatsRepository) StoreQuizAttempts(quizAttempts []questionstats.QuizAttempt) error {
+	for _, quizAttempt := range quizAttempts {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.tableName,
+			Item: map[string]types.AttributeValue{
+				"course_id":       &types.AttributeValueMemberS{Value: quizAttempt.CourseID},
+				"answer_id":       &types.AttributeValueMemberS{Value: getQuizAttemptID(quizAttempt)},
+				"window_id":       &types.AttributeValueMemberS{Value: getWindowID(quizAttempt.CourseID, quizStatsIDPrefix, quizAttempt.QuizID)},
+				"quiz_id":         &types.AttributeValueMemberS{Value: quizAttempt.QuizID},
+				"participant_id":  &types.AttributeValueMemberS{Value: quizAttempt.ParticipantID},
+				"attempt_id":      &types.AttributeValueMemberN{Value: strconv.Itoa(quizAttempt.AttemptID)},
+				"answer_count":    &types.AttributeValueMemberN{Value: strconv.Itoa(quizAttempt.AnswerCount)},
+				"time_spent_secs": &types.AttributeValueMemberN{Value: strconv.Itoa(quizAttempt.TimeSpentSecs)},
+				"ended_at":        &types.AttributeValueMemberS{Value: formatEndedAt(quizAttempt.EndedAt)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findLatestItems queries the items of the window with the latest end first until the rolling window is full.
+func (r *QuestionStatsRepository) findLatestItems(windowID string) ([]map[string]types.AttributeValue, error) {
+	var outputItems []map[string]types.AttributeValue
+	var exclusiveStartKey map[string]types.AttributeValue
+
+	for {
+		output, err := r.dbClient.Query(r.ctx, &dynamodb.QueryInput{
+			TableName: &r.tableName,
+			IndexName: aws.String(windowIndexName),
+			KeyConditions: map[string]types.Condition{
+				"window_id": {
+					ComparisonOperator: types.ComparisonOperatorEq,
+					AttributeValueList: []types.AttributeValue{
+						&types.AttributeValueMemberS{Value: windowID},
+					},
+				},
+			},
+			ScanIndexForward:  aws.Bool(false),
+			Limit:             aws.Int32(int32(questionstats.RollingWindowSize - len(outputItems))),
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		outputItems = append(outputItems, output.Items...)
+
+		if len(output.LastEvaluatedKey) == 0 || len(outputItems) >= questionstats.RollingWindowSize {
+			return outputItems, nil
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func (r *QuestionStatsRepository) FindCourseStats(courseID string) (questionstats.CourseStats, error) {
+	courseStats := questionstats.CourseStats{
+		CourseID:  courseID,
+		Questions: map[string]questionstats.QuestionStats{},
+		Quizzes:   map[string]questionstats.QuizStats{},
+	}
+	var exclusiveStartKey map[string]types.AttributeValue
+
+	for {
+		output, err := r.dbClient.Query(r.ctx, &dynamodb.QueryInput{
+			TableName: &r.courseStatsTableName,
+			KeyConditions: map[string]types.Condition{
+				"course_id": {
+					ComparisonOperator: types.ComparisonOperatorEq,
+					AttributeValueList: []types.AttributeValue{
+						&types.AttributeValueMemberS{Value: courseID},
+					},
+				},
+			},
+			ExclusiveStartKey: exclusiveStartKey,
+		})
+		if err != nil {
+			return questionstats.CourseStats{}, err
+		}
+
+		for _, outputItem := range output.Items {
+			err = addOutputItemToCourseStats(courseStats, outputItem)
+			if err != nil {
+				return questionstats.CourseStats{}, err
+			}
+		}
+
+		if len(output.LastEvaluatedKey) == 0 {
+			return courseStats, nil
+		}
+
+		exclusiveStartKey = output.LastEvaluatedKey
+	}
+}
+
+func (r *QuestionStatsRepository) StoreCourseStats(courseStats questionstats.CourseStats) error {
+	for _, questionStats := range courseStats.Questions {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.courseStatsTableName,
+			Item: map[string]types.AttributeValue{
+				"course_id":         &types.AttributeValueMemberS{Value: courseStats.CourseID},
+				"stats_id":          &types.AttributeValueMemberS{Value: questionStatsIDPrefix + questionStats.QuestionID},
+				"answer_count":      &types.AttributeValueMemberN{Value: strconv.Itoa(questionStats.AnswerCount)},
+				"correct_ratio":     &types.AttributeValueMemberN{Value: formatFloat(questionStats.CorrectRatio)},
+				"average_time_secs": &types.AttributeValueMemberN{Value: formatFloat(questionStats.AverageTimeSecs)},
+				"median_time_secs":  &types.AttributeValueMemberN{Value: formatFloat(questionStats.MedianTimeSecs)},
+				"p90_time_secs":     &types.AttributeValueMemberN{Value: formatFloat(questionStats.P90TimeSecs)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, quizStats := range courseStats.Quizzes {
+		_, err := r.dbClient.PutItem(r.ctx, &dynamodb.PutItemInput{
+			TableName: &r.courseStatsTableName,
+			Item: map[string]types.AttributeValue{
+				"course_id":                      &types.AttributeValueMemberS{Value: courseStats.CourseID},
+				"stats_id":                       &types.AttributeValueMemberS{Value: quizStatsIDPrefix + quizStats.QuizID},
+				"attempt_count":                  &types.AttributeValueMemberN{Value: strconv.Itoa(quizStats.AttemptCount)},
+				"average_time_per_question_secs": &types.AttributeValueMemberN{Value: formatFloat(quizStats.AverageTimePerQuestionSecs)},
+				"median_time_per_question_secs":  &types.AttributeValueMemberN{Value: formatFloat(quizStats.MedianTimePerQuestionSecs)},
+				"p90_time_per_question_secs":     &types.AttributeValueMemberN{Value: formatFloat(quizStats.P90TimePerQuestionSecs)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const questionStatsIDPrefix = "question#"
+const quizStatsIDPrefix = "quiz#"
+const quizAttemptIDPrefix = "attempt#"
+
+const windowIndexName = "window_index"
+
+func getAnswerID(answeredQuestion questionstats.AnsweredQuestion) string {
+	return fmt.Sprintf("%s#%s#%s#%d", answeredQuestion.QuestionID, answeredQuestion.ParticipantID, answeredQuestion.QuizID, answeredQuestion.AttemptID)
+}
+
+func getQuizAttemptID(quizAttempt questionstats.QuizAttempt) string {
+	return fmt.Sprintf("%s%s#%s#%d", quizAttemptIDPrefix, quizAttempt.ParticipantID, quizAttempt.QuizID, quizAttempt.AttemptID)
+}
+
+// getWindowID returns the key of the answered questions of a question or the attempts of a quiz in the window index.
+func getWindowID(courseID string, statsIDPrefix string, id string) string {
+	return courseID + "#" + statsIDPrefix + id
+}
+
+// formatEndedAt formats the end in UTC, so the window index sorts the ends chronologically.
+func formatEndedAt(endedAt time.Time) string {
+	return endedAt.UTC().Format(time.RFC3339)
+}
+
+func parseEndedAt(outputItem map[string]types.AttributeValue) (time.Time, error) {
+	endedAt, ok := outputItem["ended_at"].(*types.AttributeValueMemberS)
+	if !ok {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, endedAt.Value)
+}
+
+func outputItemToAnsweredQuestion(courseID string, outputItem map[string]types.AttributeValue) (questionstats.AnsweredQuestion, error) {
+	quizID, ok := outputItem["quiz_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionstats.AnsweredQuestion{}, fmt.Errorf("answered question of course %v without quiz id", courseID)
+	}
+
+	questionID, ok := outputItem["question_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionstats.AnsweredQuestion{}, fmt.Errorf("answered question of course %v without question id", courseID)
+	}
+
+	participantID, ok := outputItem["participant_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionstats.AnsweredQuestion{}, fmt.Errorf("answered question of course %v without participant id", courseID)
+	}
+
+	isCorrect, ok := outputItem["is_correct"].(*types.AttributeValueMemberBOOL)
+	if !ok {
+		return questionstats.AnsweredQuestion{}, fmt.Errorf("answered question of course %v without correctness", courseID)
+	}
+
+	attemptID, err := getNumberAttribute(outputItem, "attempt_id")
+	if err != nil {
+		return questionstats.AnsweredQuestion{}, err
+	}
+
+	timeSpentSecs, err := getNumberAttribute(outputItem, "time_spent_secs")
+	if err != nil {
+		return questionstats.AnsweredQuestion{}, err
+	}
+
+	endedAt, err := parseEndedAt(outputItem)
+	if err != nil {
+		return questionstats.AnsweredQuestion{}, err
+	}
+
+	return questionstats.AnsweredQuestion{
+		CourseID:      courseID,
+		QuizID:        quizID.Value,
+		QuestionID:    questionID.Value,
+		ParticipantID: participantID.Value,
+		AttemptID:     attemptID,
+		TimeSpentSecs: timeSpentSecs,
+		IsCorrect:     isCorrect.Value,
+		EndedAt:       endedAt,
+	}, nil
+}
+
+func outputItemToQuizAttempt(courseID string, outputItem map[string]types.AttributeValue) (questionstats.QuizAttempt, error) {
+	quizID, ok := outputItem["quiz_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionstats.QuizAttempt{}, fmt.Errorf("quiz attempt of course %v without quiz id", courseID)
+	}
+
+	participantID, ok := outputItem["participant_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return questionstats.QuizAttempt{}, fmt.Errorf("quiz attempt of course %v without participant id", courseID)
+	}
+
+	attemptID, err := getNumberAttribute(outputItem, "attempt_id")
+	if err != nil {
+		return questionstats.QuizAttempt{}, err
+	}
+
+	answerCount, err := getNumberAttribute(outputItem, "answer_count")
+	if err != nil {
+		return questionstats.QuizAttempt{}, err
+	}
+
+	timeSpentSecs, err := getNumberAttribute(outputItem, "time_spent_secs")
+	if err != nil {
+		return questionstats.QuizAttempt{}, err
+	}
+
+	endedAt, err := parseEndedAt(outputItem)
+	if err != nil {
+		return questionstats.QuizAttempt{}, err
+	}
+
+	return questionstats.QuizAttempt{
+		CourseID:      courseID,
+		QuizID:        quizID.Value,
+		ParticipantID: participantID.Value,
+		AttemptID:     attemptID,
+		AnswerCount:   answerCount,
+		TimeSpentSecs: timeSpentSecs,
+		EndedAt:       endedAt,
+	}, nil
+}
+
+func addOutputItemToCourseStats(courseStats questionstats.CourseStats, outputItem map[string]types.AttributeValue) error {
+	statsID, ok := outputItem["stats_id"].(*types.AttributeValueMemberS)
+	if !ok {
+		return fmt.Errorf("stats of course %v without stats id", courseStats.CourseID)
+	}
+
+	var err error
+	numbers := map[string]float64{}
+	for name, attribute := range outputItem {
+		numberAttribute, ok := attribute.(*types.AttributeValueMemberN)
+		if !ok {
+			continue
+		}
+
+		numbers[name], err = strconv.ParseFloat(numberAttribute.Value, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(statsID.Value, questionStatsIDPrefix):
+		questionID := strings.TrimPrefix(statsID.Value, questionStatsIDPrefix)
+		courseStats.Questions[questionID] = questionstats.QuestionStats{
+			QuestionID:      questionID,
+			AnswerCount:     int(numbers["answer_count"]),
+			CorrectRatio:    numbers["correct_ratio"],
+			AverageTimeSecs: numbers["average_time_secs"],
+			MedianTimeSecs:  numbers["median_time_secs"],
+			P90TimeSecs:     numbers["p90_time_secs"],
+		}
+	case strings.HasPrefix(statsID.Value, quizStatsIDPrefix):
+		quizID := strings.TrimPrefix(statsID.Value, quizStatsIDPrefix)
+		courseStats.Quizzes[quizID] = questionstats.QuizStats{
+			QuizID:                     quizID,
+			AttemptCount:               int(numbers["attempt_count"]),
+			AverageTimePerQuestionSecs: numbers["average_time_per_question_secs"],
+			MedianTimePerQuestionSecs:  numbers["median_time_per_question_secs"],
+			P90TimePerQuestionSecs:     numbers["p90_time_per_question_secs"],
+		}
+	default:
+		return fmt.Errorf("stats of course %v with unknown stats id %v", courseStats.CourseID, statsID.Value)
+	}
+
+	return nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,115 @@
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/questionstats"
+	dynamodb "learn-to-code/internal/infrastructure/dynamodb"
+	errUtils "learn-to-code/internal/infrastructure/go/util/err"
+	"learn-to-code/internal/infrastructure/testing/db"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var endedAt = time.Date(2023, 11, 6, 12, 0, 0, 0, time.UTC)
+
+func TestQuestionStatsRepository_StoreAnsweredQuestions_ReplacesAnswerOfAttempt(t *testing.T) {
+	repo, clean := getQuestionStatsRepository()
+	defer clean()
+
+	errUtils.PanicIfError(repo.StoreAnsweredQuestions([]questionstats.AnsweredQuestion{
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 20, EndedAt: endedAt},
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question", ParticipantID: "alice", AttemptID: 2, TimeSpentSecs: 10, IsCorrect: true, EndedAt: endedAt.Add(time.Hour)},
+		{CourseID: "course", QuizID: "quiz", QuestionID: "other-question", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 5, EndedAt: endedAt},
+		{CourseID: "other-course", QuizID: "quiz", QuestionID: "question", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 5, EndedAt: endedAt},
+	}))
+	errUtils.PanicIfError(repo.StoreAnsweredQuestions([]questionstats.AnsweredQuestion{
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 30, IsCorrect: true, EndedAt: endedAt},
+	}))
+
+	answeredQuestions := errUtils.PanicIfError1(repo.FindLatestAnsweredQuestions("course", "question"))
+
+	if len(answeredQuestions) != 2 {
+		t.Fatalf("expected 2 answered questions of the question in the course, got %v", answeredQuestions)
+	}
+
+	expectedAnsweredQuestion := questionstats.AnsweredQuestion{CourseID: "course", QuizID: "quiz", QuestionID: "question", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 30, IsCorrect: true, EndedAt: endedAt}
+	if answeredQuestions[1] != expectedAnsweredQuestion {
+		t.Fatalf("expected the replaced answer of the first attempt after the latest answer, got %v", answeredQuestions[1])
+	}
+}
+
+func TestQuestionStatsRepository_FindLatestAnsweredQuestions_ReturnsRollingWindow(t *testing.T) {
+	repo, clean := getQuestionStatsRepository()
+	defer clean()
+
+	var answeredQuestions []questionstats.AnsweredQuestion
+	for i := 0; i <= questionstats.RollingWindowSize; i++ {
+		answeredQuestions = append(answeredQuestions, questionstats.AnsweredQuestion{
+			CourseID: "course", QuizID: "quiz", QuestionID: "question", ParticipantID: fmt.Sprintf("participant-%d", i), AttemptID: 1, EndedAt: endedAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+	errUtils.PanicIfError(repo.StoreAnsweredQuestions(answeredQuestions))
+
+	latestAnsweredQuestions := errUtils.PanicIfError1(repo.FindLatestAnsweredQuestions("course", "question"))
+
+	if len(latestAnsweredQuestions) != questionstats.RollingWindowSize || latestAnsweredQuestions[len(latestAnsweredQuestions)-1].ParticipantID != "participant-1" {
+		t.Fatalf("expected the earliest answer to drop out of the rolling window, got %d answers", len(latestAnsweredQuestions))
+	}
+}
+
+func TestQuestionStatsRepository_StoreQuizAttempts_FindsLatestAttemptsOfQuiz(t *testing.T) {
+	repo, clean := getQuestionStatsRepository()
+	defer clean()
+
+	quizAttempts := []questionstats.QuizAttempt{
+		{CourseID: "course", QuizID: "quiz", ParticipantID: "alice", AttemptID: 1, AnswerCount: 2, TimeSpentSecs: 40, EndedAt: endedAt},
+		{CourseID: "course", QuizID: "quiz", ParticipantID: "bob", AttemptID: 1, AnswerCount: 1, TimeSpentSecs: 90, EndedAt: endedAt.Add(time.Hour)},
+		{CourseID: "course", QuizID: "other-quiz", ParticipantID: "alice", AttemptID: 1, AnswerCount: 1, TimeSpentSecs: 10, EndedAt: endedAt},
+	}
+	errUtils.PanicIfError(repo.StoreQuizAttempts(quizAttempts))
+
+	foundQuizAttempts := errUtils.PanicIfError1(repo.FindLatestQuizAttempts("course", "quiz"))
+
+	if !reflect.DeepEqual(foundQuizAttempts, []questionstats.QuizAttempt{quizAttempts[1], quizAttempts[0]}) {
+		t.Fatalf("expected the attempts of the quiz with the latest first, got %v", foundQuizAttempts)
+	}
+}
+
+func TestQuestionStatsRepository_StoreCourseStats_FindsStatsOfCourse(t *testing.T) {
+	repo, clean := getQuestionStatsRepository()
+	defer clean()
+
+	answeredQuestions := []questionstats.AnsweredQuestion{
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question-1", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 10, IsCorrect: true},
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question-2", ParticipantID: "alice", AttemptID: 1, TimeSpentSecs: 25},
+		{CourseID: "course", QuizID: "quiz", QuestionID: "question-1", ParticipantID: "bob", AttemptID: 1, TimeSpentSecs: 40},
+	}
+	courseStats := questionstats.CourseStats{
+		CourseID:  "course",
+		Questions: questionstats.Aggregate(answeredQuestions),
+		Quizzes:   questionstats.AggregateQuizzes(questionstats.NewQuizAttempts(answeredQuestions)),
+	}
+	errUtils.PanicIfError(repo.StoreCourseStats(courseStats))
+
+	foundCourseStats := errUtils.PanicIfError1(repo.FindCourseStats("course"))
+
+	if !reflect.DeepEqual(foundCourseStats, courseStats) {
+		t.Fatalf("expected the stored course stats %v, got %v", courseStats, foundCourseStats)
+	}
+
+	otherCourseStats := errUtils.PanicIfError1(repo.FindCourseStats("other-course"))
+
+	if len(otherCourseStats.Questions) != 0 || len(otherCourseStats.Quizzes) != 0 {
+		t.Fatalf("expected empty stats of a course without stats, got %v", otherCourseStats)
+	}
+}
+
+func getQuestionStatsRepository() (questionstats.Repository, func()) {
+	dynamoDbClient, clean := db.StartDynamoDB()
+
+	repo := dynamodb.NewDynamoDbQuestionStatsRepository(context.Background(), "test", dynamoDbClient)
+
+	return repo, clean
+}
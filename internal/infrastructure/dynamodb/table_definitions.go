@@ -6,9 +6,10 @@ import (
 )
 
 type TableDefinition struct {
-	TableName            string
-	KeySchemas           []types.KeySchemaElement
-	AttributeDefinitions []types.AttributeDefinition
+	TableName              string
+	KeySchemas             []types.KeySchemaElement
+	AttributeDefinitions   []types.AttributeDefinition
+	GlobalSecondaryIndexes []types.GlobalSecondaryIndex
 }
 
 func GetAllTableDefinitions() []TableDefinition {
@@ -36,5 +37,123 @@ func GetAllTableDefinitions() []TableDefinition {
 				},
 			},
 		},
+		{
+			TableName: "test_leaderboard",
+			KeySchemas: []types.KeySchemaElement{
+				{
+					AttributeName: aws.String("leaderboard_id"),
+					KeyType:       types.KeyTypeHash,
+				},
+				{
+					AttributeName: aws.String("participant_id"),
+					KeyType:       types.KeyTypeRange,
+				},
+			},
+			AttributeDefinitions: []types.AttributeDefinition{
+				{
+					AttributeName: aws.String("leaderboard_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("participant_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+			},
+		},
+		{
+			TableName: "test_question_rating",
+			KeySchemas: []types.KeySchemaElement{
+				{
+					AttributeName: aws.String("course_id"),
+					KeyType:       types.KeyTypeHash,
+				},
+				{
+					AttributeName: aws.String("rating_id"),
+					KeyType:       types.KeyTypeRange,
+				},
+			},
+			AttributeDefinitions: []types.AttributeDefinition{
+				{
+					AttributeName: aws.String("course_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("rating_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+			},
+		},
+		{
+			TableName: "test_question_stats",
+			KeySchemas: []types.KeySchemaElement{
+				{
+					AttributeName: aws.String("course_id"),
+					KeyType:       types.KeyTypeHash,
+				},
+				{
+					AttributeName: aws.String("answer_id"),
+					KeyType:       types.KeyTypeRange,
+				},
+			},
+			AttributeDefinitions: []types.AttributeDefinition{
+				{
+					AttributeName: aws.String("course_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("answer_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("window_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("ended_at"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+			},
+			GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+				{
+					IndexName: aws.String(windowIndexName),
+					KeySchema: []types.KeySchemaElement{
+						{
+							AttributeName: aws.String("window_id"),
+							KeyType:       types.KeyTypeHash,
+						},
+						{
+							AttributeName: aws.String("ended_at"),
+							KeyType:       types.KeyTypeRange,
+						},
+					},
+					Projection: &types.Projection{
+						ProjectionType: types.ProjectionTypeAll,
+					},
+				},
+			},
+		},
+		{
+			TableName: "test_course_stats",
+			KeySchemas: []types.KeySchemaElement{
+				{
+					AttributeName: aws.String("course_id"),
+					KeyType:       types.KeyTypeHash,
+				},
+				{
+					AttributeName: aws.String("stats_id"),
+					KeyType:       types.KeyTypeRange,
+				},
+			},
+			AttributeDefinitions: []types.AttributeDefinition{
+				{
+					AttributeName: aws.String("course_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+				{
+					AttributeName: aws.String("stats_id"),
+					AttributeType: types.ScalarAttributeTypeS,
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/static"
+)
+
+func NewBadgeRuleRepository() *BadgeRuleRepository {
+	return &BadgeRuleRepository{}
+}
+
+// BadgeRuleRepository reads the badge rules defined next to the course content
+type BadgeRuleRepository struct {
+}
+
+type badgeRuleJSON struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Condition   string `json:"condition"`
+	Count       int    `json:"count"`
+	WithinDays  int    `json:"withinDays"`
+}
+
+func (r *BadgeRuleRepository) FindRulesByCourseID(courseID string) ([]badge.Rule, error) {
+	file, err := fs.ReadFile(static.StaticJSONFiles, fmt.Sprintf("json/course/%s/badges.json", courseID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var badgeRulesJSON []badgeRuleJSON
+	err = json.Unmarshal(file, &badgeRulesJSON)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse badge rules of course %s: %w", courseID, err)
+	}
+
+	rules := make([]badge.Rule, 0, len(badgeRulesJSON))
+	for _, ruleJSON := range badgeRulesJSON {
+		rule := badge.Rule{
+			BadgeID:     ruleJSON.ID,
+			Name:        ruleJSON.Name,
+			Description: ruleJSON.Description,
+			Condition:   badge.Condition(ruleJSON.Condition),
+			Count:       ruleJSON.Count,
+			WithinDays:  ruleJSON.WithinDays,
+		}
+
+		err = rule.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,33 @@
+package inmemory
+
+import (
+	"testing"
+)
+
+func TestFindRulesByCourseID_ParsesBadgeRules(t *testing.T) {
+	rules, err := NewBadgeRuleRepository().FindRulesByCourseID(CourseIDFrontendDevelopment)
+	if err != nil {
+		t.Fatalf("FindRulesByCourseID returned an error: %v", err)
+	}
+
+	if len(rules) == 0 {
+		t.Fatalf("No badge rules were parsed")
+	}
+
+	for _, rule := range rules {
+		if rule.BadgeID == "" || rule.Name == "" || rule.Condition == "" {
+			t.Errorf("Badge rule fields are not correctly parsed: %v", rule)
+		}
+	}
+}
+
+func TestFindRulesByCourseID_ReturnsNoRulesForCourseWithoutBadges(t *testing.T) {
+	rules, err := NewBadgeRuleRepository().FindRulesByCourseID("unknown")
+	if err != nil {
+		t.Fatalf("FindRulesByCourseID returned an error: %v", err)
+	}
+
+	if len(rules) != 0 {
+		t.Fatalf("expected no badge rules, got %v", rules)
+	}
+}
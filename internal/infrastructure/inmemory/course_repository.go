@@ -137,6 +137,9 @@ func (q *CourseRepository) FindByID(courseID string) (course.Course, error) {
 					ID:      CourseStepIDJavaScriptAdvanced,
 					Name:    "Advanced JavaScript",
 					Quizzes: []course.StepQuiz{q.mapQuiz(quizAdvancedJavaScript)},
+					Prerequisites: []course.Prerequisite{
+						{StepIDs: []string{CourseStepIDJavaScriptBasics}},
+					},
 				},
 				{
 					ID:      CourseStepIDGit,
@@ -162,6 +165,10 @@ func (q *CourseRepository) FindByID(courseID string) (course.Course, error) {
 					ID:      CourseStepIDReact,
 					Name:    "React",
 					Quizzes: []course.StepQuiz{q.mapQuiz(quizReact)},
+					Prerequisites: []course.Prerequisite{
+						{StepIDs: []string{CourseStepIDJavaScriptBasics}},
+						{StepIDs: []string{CourseStepIDJavaScriptAdvanced, CourseStepIDTypeScript}, MinPassedSteps: 1},
+					},
 				}, {
 					ID:      CourseStepIDCIGithubActions,
 					Name:    "GitHub Actions",
@@ -174,6 +181,31 @@ func (q *CourseRepository) FindByID(courseID string) (course.Course, error) {
 	return course.Course{}, course.ErrCourseNotFound
 }
 
+func (q *CourseRepository) FindQuizByID(quizID string) (course.StepQuiz, error) {
+	c, err := q.FindByQuizID(quizID)
+	if err != nil {
+		return course.StepQuiz{}, err
+	}
+
+	stepQuiz, _ := c.FindQuiz(quizID)
+
+	return stepQuiz, nil
+}
+
+func (q *CourseRepository) FindByQuizID(quizID string) (course.Course, error) {
+	c, err := q.FindByID(CourseIDFrontendDevelopment)
+	if err != nil {
+		return course.Course{}, err
+	}
+
+	_, ok := c.FindQuiz(quizID)
+	if !ok {
+		return course.Course{}, course.ErrQuizNotFound
+	}
+
+	return c, nil
+}
+
 func (q *CourseRepository) getQuiz(courseID string, quizID string) (responseobject.StepQuiz, error) {
 	file, err := q.readQuizFromFile(courseID, quizID)
 	if err != nil {
@@ -209,8 +241,16 @@ func (q *CourseRepository) readQuizFromFile(requestedCourseID string, requestedQ
 
 func (q *CourseRepository) mapQuiz(quiz responseobject.StepQuiz) course.StepQuiz {
 	return course.StepQuiz{
-		ID:        quiz.ID,
-		Questions: mapQuestions(quiz.Questions),
+		ID:                  quiz.ID,
+		Questions:           mapQuestions(quiz.Questions),
+		DurationMins:        quiz.DurationMins,
+		MaxAttempts:         quiz.MaxAttempts,
+		CooldownMins:        quiz.CooldownMins,
+		QuestionsPerAttempt: quiz.QuestionsPerAttempt,
+		DifficultyQuotas:    quiz.DifficultyQuotas,
+		Adaptive:            quiz.Adaptive,
+		FeedbackDisabled:    quiz.FeedbackDisabled,
+		HintPenalty:         quiz.HintPenalty,
 	}
 }
 
@@ -225,6 +265,13 @@ func mapQuestions(responseObjectQuestions []responseobject.QuizQuestion) []cours
 			Answers:     mapAnswers(responseObjectQuestion.Answers),
 			Rating:      float64(responseObjectQuestion.Rating),
 			RatingCount: responseObjectQuestion.RatingCount,
+			Hints:       responseObjectQuestion.Hints,
+
+			AnswerCount:     responseObjectQuestion.AnswerCount,
+			CorrectRatio:    responseObjectQuestion.CorrectRatio,
+			AverageTimeSecs: responseObjectQuestion.AverageTimeSecs,
+			MedianTimeSecs:  responseObjectQuestion.MedianTimeSecs,
+			P90TimeSecs:     responseObjectQuestion.P90TimeSecs,
 		})
 	}
 
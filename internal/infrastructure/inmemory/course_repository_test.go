@@ -1,6 +1,8 @@
 package inmemory
 
 import (
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
 	"testing"
 )
 
@@ -41,3 +43,25 @@ func TestFindByID_ReturnsErrorForUnkownCourse(t *testing.T) {
 		t.Fatalf("FindByID returned no error for unknown course")
 	}
 }
+
+func TestFindQuizByID_ReturnsQuizOfCourse(t *testing.T) {
+	repo := NewCourseRepository()
+	q, err := repo.FindQuizByID(QuizIDEssentialsOfTheWeb)
+
+	if err != nil {
+		t.Fatalf("FindQuizByID returned an error: %v", err)
+	}
+
+	if q.ID != QuizIDEssentialsOfTheWeb {
+		t.Errorf("expected quiz %s, got %s", QuizIDEssentialsOfTheWeb, q.ID)
+	}
+}
+
+func TestFindQuizByID_ReturnsErrorForUnknownQuiz(t *testing.T) {
+	repo := NewCourseRepository()
+	_, err := repo.FindQuizByID("unknown")
+
+	if !errors.Is(err, course.ErrQuizNotFound) {
+		t.Fatalf("expected quiz not found error, got %v", err)
+	}
+}
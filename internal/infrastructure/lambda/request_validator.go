@@ -1,20 +1,26 @@
 package lambda
 
 import (
+	"errors"
+	"fmt"
 	authJwt "learn-to-code/internal/infrastructure/authentication/jwt"
 
 	"github.com/aws/aws-lambda-go/events"
 )
 
+var ErrNotAdmin = errors.New("participant is not an admin")
+
 type RequestValidator struct {
-	nextJsSecretParser *NextJsSecretParser
-	jwtTokenValidator  *authJwt.Validator
+	nextJsSecretParser  *NextJsSecretParser
+	jwtTokenValidator   *authJwt.Validator
+	adminParticipantIDs []string
 }
 
-func NewRequestValidator(nextJsSecretParser *NextJsSecretParser, jwtTokenValidator *authJwt.Validator) *RequestValidator {
+func NewRequestValidator(nextJsSecretParser *NextJsSecretParser, jwtTokenValidator *authJwt.Validator, adminParticipantIDs []string) *RequestValidator {
 	return &RequestValidator{
-		nextJsSecretParser: nextJsSecretParser,
-		jwtTokenValidator:  jwtTokenValidator,
+		nextJsSecretParser:  nextJsSecretParser,
+		jwtTokenValidator:   jwtTokenValidator,
+		adminParticipantIDs: adminParticipantIDs,
 	}
 }
 
@@ -39,3 +45,19 @@ func (r RequestValidator) ValidateRequest(request events.APIGatewayProxyRequest)
 
 	return userID, nil
 }
+
+// ValidateAdminRequest validates the request like ValidateRequest and returns ErrNotAdmin if the user is no admin.
+func (r RequestValidator) ValidateAdminRequest(request events.APIGatewayProxyRequest) (string, error) {
+	userID, err := r.ValidateRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	for _, adminParticipantID := range r.adminParticipantIDs {
+		if adminParticipantID == userID {
+			return userID, nil
+		}
+	}
+
+	return "", fmt.Errorf("user %v: %w", userID, ErrNotAdmin)
+}
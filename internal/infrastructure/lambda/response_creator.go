@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
@@ -33,6 +34,20 @@ func (r *ResponseCreator) CreateSuccessResponse(responeObject any) (events.APIGa
 	}, nil
 }
 
+// CreatePdfResponse returns the pdf as download, API Gateway decodes the base64 body for binary media types.
+func (r *ResponseCreator) CreatePdfResponse(fileName string, pdf []byte) (events.APIGatewayProxyResponse, error) {
+	headers := r.getHeaders()
+	headers["Content-Type"] = "application/pdf"
+	headers["Content-Disposition"] = fmt.Sprintf(`attachment; filename="%s"`, fileName)
+
+	return events.APIGatewayProxyResponse{
+		Body:            base64.StdEncoding.EncodeToString(pdf),
+		IsBase64Encoded: true,
+		Headers:         headers,
+		StatusCode:      200,
+	}, nil
+}
+
 func (r *ResponseCreator) CreateServerErrorResponse(err error) (events.APIGatewayProxyResponse, error) {
 	return events.APIGatewayProxyResponse{
 		StatusCode: 500,
@@ -49,6 +64,22 @@ func (r *ResponseCreator) CreateClientErrorResponse(err error) (events.APIGatewa
 	}, nil
 }
 
+func (r *ResponseCreator) CreateForbiddenResponse(err error) (events.APIGatewayProxyResponse, error) {
+	return events.APIGatewayProxyResponse{
+		StatusCode: 403,
+		Body:       fmt.Sprintf(`{"error": "%s"}`, err),
+		Headers:    r.getHeaders(),
+	}, nil
+}
+
+func (r *ResponseCreator) CreateConflictResponse(err error) (events.APIGatewayProxyResponse, error) {
+	return events.APIGatewayProxyResponse{
+		StatusCode: 409,
+		Body:       fmt.Sprintf(`{"error": "%s"}`, err),
+		Headers:    r.getHeaders(),
+	}, nil
+}
+
 func (r *ResponseCreator) CreateNotFoundResponse() (events.APIGatewayProxyResponse, error) {
 	return events.APIGatewayProxyResponse{
 		StatusCode: 404,
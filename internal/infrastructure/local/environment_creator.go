@@ -13,6 +13,9 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 )
 
+// AdminParticipantID is allowed to moderate in the local environment.
+const AdminParticipantID = "admin"
+
 type EnvironmentCreator struct {
 	Cfg              config.Config
 	requestCreator   *RequestCreator
@@ -46,6 +49,8 @@ func setupExecutionEnvironment(environment config.Environment) config.Config {
 	os.Setenv(config.EnvEnvironmentKey, string(environment))
 	os.Setenv(config.EnvJwtSecretKey, "test")
 	os.Setenv(config.EnvCorsAllowOriginKey, "http://localhost:3000")
+	os.Setenv(config.EnvCertificateSecretKey, "test")
+	os.Setenv(config.EnvAdminParticipantIDsKey, AdminParticipantID)
 	cfg := err.PanicIfError1(config.NewConfig())
 	return cfg
 }
@@ -79,6 +84,18 @@ func (ec *EnvironmentCreator) ExecuteLambdaHandlerGETWithPathParametersForUser(
 	return request
 }
 
+func (ec *EnvironmentCreator) ExecuteLambdaHandlerGETWithQueryParametersForUser(
+	participantID string,
+	newLambdaHandlerFn func(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler,
+	pathParameters map[string]string,
+	queryParameters map[string]string,
+) events.APIGatewayProxyResponse {
+
+	handler := newLambdaHandlerFn(ec.Cfg, ec.RegistryOverride)
+	request := err.PanicIfError1(handler.HandleRequest(context.Background(), ec.requestCreator.CreateGETRequestWithQueryParameters(pathParameters, queryParameters, participantID)))
+	return request
+}
+
 func (ec *EnvironmentCreator) ExecuteLambdaHandlerGETWithPathParameters(
 	newLambdaHandlerFn func(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler,
 	pathParameters map[string]string,
@@ -37,6 +37,13 @@ func (r *RequestCreator) CreateGETRequest(pathParameters map[string]string, part
 	}
 }
 
+func (r *RequestCreator) CreateGETRequestWithQueryParameters(pathParameters map[string]string, queryParameters map[string]string, participantID string) events.APIGatewayProxyRequest {
+	request := r.CreateGETRequest(pathParameters, participantID)
+	request.QueryStringParameters = queryParameters
+
+	return request
+}
+
 func (r *RequestCreator) CreatePOSTRequest(body string, pathParameters map[string]string, participantID string) events.APIGatewayProxyRequest {
 	return events.APIGatewayProxyRequest{
 		Resource:                        "",
@@ -0,0 +1,109 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Document is a single page PDF document. It only uses the standard Helvetica fonts every PDF reader provides,
+// so no fonts have to be embedded.
+type Document struct {
+	width    float64
+	height   float64
+	contents []string
+}
+
+// averageCharWidth approximates the Helvetica glyph width relative to the font size to center text.
+const averageCharWidth = 0.52
+
+// NewLandscapeA4Document creates an empty A4 page in landscape orientation, sizes are in points.
+func NewLandscapeA4Document() *Document {
+	return &Document{
+		width:  842,
+		height: 595,
+	}
+}
+
+func (d *Document) GetWidth() float64 {
+	return d.width
+}
+
+func (d *Document) GetHeight() float64 {
+	return d.height
+}
+
+// AddText writes the text with its baseline starting at x and y, measured from the bottom left corner.
+func (d *Document) AddText(x float64, y float64, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+
+	d.contents = append(d.contents, fmt.Sprintf("BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET", font, size, x, y, escapeText(text)))
+}
+
+// AddCenteredText writes the text horizontally centered on the page.
+func (d *Document) AddCenteredText(y float64, size float64, bold bool, text string) {
+	textWidth := float64(len([]rune(text))) * size * averageCharWidth
+
+	d.AddText((d.width-textWidth)/2, y, size, bold, text)
+}
+
+// AddRectangle draws the outline of a rectangle with its bottom left corner at x and y.
+func (d *Document) AddRectangle(x float64, y float64, width float64, height float64, lineWidth float64) {
+	d.contents = append(d.contents, fmt.Sprintf("%.2f w %.2f %.2f %.2f %.2f re S", lineWidth, x, y, width, height))
+}
+
+func (d *Document) Bytes() []byte {
+	content := strings.Join(d.contents, "\n")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Contents 4 0 R /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> >>", d.width, d.height),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buffer.Len()
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xrefOffset := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xrefOffset)
+
+	return buffer.Bytes()
+}
+
+// escapeText escapes the text for a PDF string literal. Characters outside of Latin-1 can not be shown
+// by the standard fonts and are replaced.
+func escapeText(text string) string {
+	var escaped bytes.Buffer
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteByte('\\')
+			escaped.WriteRune(r)
+		case r < 32 || r > 255:
+			escaped.WriteByte('?')
+		case r > 127:
+			fmt.Fprintf(&escaped, "\\%03o", r)
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
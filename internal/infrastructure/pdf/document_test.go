@@ -0,0 +1,50 @@
+package pdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"learn-to-code/internal/infrastructure/pdf"
+	"strings"
+	"testing"
+)
+
+func TestDocument_Bytes_CreatesPdf(t *testing.T) {
+	document := pdf.NewLandscapeA4Document()
+	document.AddCenteredText(300, 24, true, "Certificate")
+
+	content := document.Bytes()
+
+	if !bytes.HasPrefix(content, []byte("%PDF-1.4\n")) {
+		t.Fatalf("expected pdf header, got %s", content[:10])
+	}
+
+	if !bytes.HasSuffix(content, []byte("%%EOF\n")) {
+		t.Fatalf("expected pdf trailer")
+	}
+
+	if !bytes.Contains(content, []byte("(Certificate) Tj")) {
+		t.Fatalf("expected the text to be part of the content stream")
+	}
+}
+
+func TestDocument_Bytes_ReferencesObjectOffsets(t *testing.T) {
+	content := string(pdf.NewLandscapeA4Document().Bytes())
+
+	xref := content[strings.Index(content, "xref\n"):]
+	firstObjectOffset := strings.Index(content, "1 0 obj")
+
+	if !strings.Contains(xref, fmt.Sprintf("%010d 00000 n", firstObjectOffset)) {
+		t.Fatalf("expected xref to contain the offset %d of the first object", firstObjectOffset)
+	}
+}
+
+func TestDocument_AddText_EscapesText(t *testing.T) {
+	document := pdf.NewLandscapeA4Document()
+	document.AddText(0, 0, 12, false, "JavaScript (Basics) ü €")
+
+	content := string(document.Bytes())
+
+	if !strings.Contains(content, `(JavaScript \(Basics\) \374 ?) Tj`) {
+		t.Fatalf("expected escaped text, got %s", content)
+	}
+}
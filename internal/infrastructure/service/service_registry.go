@@ -5,13 +5,22 @@ import (
 	"learn-to-code/internal/application"
 	"learn-to-code/internal/domain/command"
 	authJwt "learn-to-code/internal/infrastructure/authentication/jwt"
+	"learn-to-code/internal/infrastructure/certificate"
+	"learn-to-code/internal/infrastructure/clock"
 	config2 "learn-to-code/internal/infrastructure/config"
 	"learn-to-code/internal/infrastructure/dynamodb"
 	"learn-to-code/internal/infrastructure/go/util/err"
 	"learn-to-code/internal/infrastructure/inmemory"
 	"learn-to-code/internal/infrastructure/lambda"
+	certificatemapper "learn-to-code/internal/interfaces/lambda/certificate/mapper"
 	"learn-to-code/internal/interfaces/lambda/course/mapper"
+	leaderboardmapper "learn-to-code/internal/interfaces/lambda/leaderboard/mapper"
+	moderationmapper "learn-to-code/internal/interfaces/lambda/moderation/mapper"
+	badgemapper "learn-to-code/internal/interfaces/lambda/participant/badge/mapper"
+	progressmapper "learn-to-code/internal/interfaces/lambda/participant/progress/mapper"
 	mapper2 "learn-to-code/internal/interfaces/lambda/participant/quiz/mapper"
+	reviewmapper "learn-to-code/internal/interfaces/lambda/participant/review/mapper"
+	statsmapper "learn-to-code/internal/interfaces/lambda/participant/stats/mapper"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	dynamodbsdk "github.com/aws/aws-sdk-go-v2/service/dynamodb"
@@ -19,6 +28,7 @@ import (
 
 type RegistryOverride struct {
 	DynamoDBClient *dynamodbsdk.Client
+	Clock          clock.Clock
 }
 
 type Registry struct {
@@ -27,47 +37,119 @@ type Registry struct {
 
 	ParticipantApplicationService *application.ParticipantApplicationService
 	QuizOverviewMapper            *mapper2.QuizOverviewMapper
+	CommandResultMapper           *mapper2.CommandResultMapper
 
 	CourseApplicationService *application.CourseApplicationService
 	CourseMapper             *mapper.CourseMapper
 	QuizAttemptDetailMapper  *mapper2.QuizAttemptDetailMapper
+	AttemptReviewMapper      *mapper2.AttemptReviewMapper
+	NextQuestionMapper       *mapper2.NextQuestionMapper
+	ActiveAttemptMapper      *mapper2.ActiveAttemptMapper
+	DueReviewsMapper         *reviewmapper.DueReviewsMapper
+	CourseProgressMapper     *progressmapper.CourseProgressMapper
+	BadgesMapper             *badgemapper.BadgesMapper
+	ParticipantStatsMapper   *statsmapper.ParticipantStatsMapper
+
+	CertificateApplicationService *application.CertificateApplicationService
+	CertificateMapper             *certificatemapper.CertificateMapper
+
+	LeaderboardApplicationService *application.LeaderboardApplicationService
+	LeaderboardMapper             *leaderboardmapper.LeaderboardMapper
+
+	QuestionRatingApplicationService *application.QuestionRatingApplicationService
+
+	QuestionStatsApplicationService *application.QuestionStatsApplicationService
+
+	ModerationApplicationService *application.ModerationApplicationService
+	ReportsMapper                *moderationmapper.ReportsMapper
+
+	RegradeApplicationService *application.RegradeApplicationService
+	RegradeMapper             *moderationmapper.RegradeMapper
 }
 
 func NewServiceRegistry(ctx context.Context, cfg config2.Config, registryOverrides ...RegistryOverride) *Registry {
 	dynamoDbClient := createDynamoDbClient(ctx, cfg.Environment, cfg.DefaultAwsRegion)
 
+	var applicationClock clock.Clock = clock.NewSystemClock()
+
 	for _, registryOverride := range registryOverrides {
 		if registryOverride.DynamoDBClient != nil {
 			dynamoDbClient = registryOverride.DynamoDBClient
 		}
+
+		if registryOverride.Clock != nil {
+			applicationClock = registryOverride.Clock
+		}
 	}
 
 	nextJsSecretParser := lambda.NewNextJsSecretParser()
 	jwtTokenValidator := authJwt.NewValidator(cfg.JwtSecret)
-	requestValidator := lambda.NewRequestValidator(nextJsSecretParser, jwtTokenValidator)
+	requestValidator := lambda.NewRequestValidator(nextJsSecretParser, jwtTokenValidator, cfg.AdminParticipantIDs)
 	responseCreator := lambda.NewResponseCreator(cfg.CorsAllowOrigin)
 
 	courseRepository := inmemory.NewCourseRepository()
+	badgeRuleRepository := inmemory.NewBadgeRuleRepository()
 	courseApplicationService := application.NewCourseApplicationService(courseRepository)
 	courseMapper := mapper.NewCourseMapper()
 
-	startQuizToEventMapper := command.NewParticipantCommandApplier(courseRepository)
+	certificateSigner := certificate.NewHmacSigner(cfg.CertificateSecret)
+	questionStatsRepository := dynamodb.NewDynamoDbQuestionStatsRepository(ctx, cfg.Environment, dynamoDbClient)
+	startQuizToEventMapper := command.NewParticipantCommandApplier(courseRepository, badgeRuleRepository, certificateSigner, questionStatsRepository)
 
 	eventPODeserializer := dynamodb.NewEventPODeserializer()
 	participantRepositoryFactory := dynamodb.NewParticipantRepositoryFactory(cfg.Environment, dynamoDbClient, eventPODeserializer)
 	participantRepository := participantRepositoryFactory.NewRepository(ctx)
-	participantApplicationService := application.NewPartcipantApplicationService(participantRepository, startQuizToEventMapper)
+	participantApplicationService := application.NewPartcipantApplicationService(participantRepository, courseRepository, badgeRuleRepository, startQuizToEventMapper, applicationClock)
 	quizOverviewMapper := mapper2.NewQuizOverviewMapper()
+	commandResultMapper := mapper2.NewCommandResultMapper()
 	quizAttemptDetailMapper := mapper2.NewQuizAttemptDetailMapper()
+	attemptReviewMapper := mapper2.NewAttemptReviewMapper()
+	nextQuestionMapper := mapper2.NewNextQuestionMapper(courseMapper)
+	activeAttemptMapper := mapper2.NewActiveAttemptMapper(courseMapper)
+	dueReviewsMapper := reviewmapper.NewDueReviewsMapper()
+	courseProgressMapper := progressmapper.NewCourseProgressMapper()
+	badgesMapper := badgemapper.NewBadgesMapper()
+	participantStatsMapper := statsmapper.NewParticipantStatsMapper()
+	certificateApplicationService := application.NewCertificateApplicationService(participantRepository, courseRepository, certificateSigner)
+	certificateMapper := certificatemapper.NewCertificateMapper()
+	leaderboardRepository := dynamodb.NewDynamoDbLeaderboardRepository(ctx, cfg.Environment, dynamoDbClient)
+	leaderboardApplicationService := application.NewLeaderboardApplicationService(participantRepository, courseRepository, leaderboardRepository, applicationClock)
+	leaderboardMapper := leaderboardmapper.NewLeaderboardMapper()
+	questionRatingRepository := dynamodb.NewDynamoDbQuestionRatingRepository(ctx, cfg.Environment, dynamoDbClient)
+	questionRatingApplicationService := application.NewQuestionRatingApplicationService(participantRepository, courseRepository, questionRatingRepository)
+	questionStatsApplicationService := application.NewQuestionStatsApplicationService(participantRepository, courseRepository, questionStatsRepository)
+	moderationQueueRepository := dynamodb.NewDynamoDbModerationQueueRepository(ctx, cfg.Environment, dynamoDbClient, eventPODeserializer)
+	moderationApplicationService := application.NewModerationApplicationService(participantRepository, moderationQueueRepository, command.NewModerationCommandApplier())
+	reportsMapper := moderationmapper.NewReportsMapper()
+	regradeApplicationService := application.NewRegradeApplicationService(participantRepository, courseRepository, startQuizToEventMapper)
+	regradeMapper := moderationmapper.NewRegradeMapper()
 
 	registry := &Registry{
-		ParticipantApplicationService: err.PanicIfNil("participantApplicationService", participantApplicationService),
-		CourseApplicationService:      err.PanicIfNil("courseApplicationService", courseApplicationService),
-		CourseMapper:                  err.PanicIfNil("courseMapper", courseMapper),
-		QuizOverviewMapper:            err.PanicIfNil("quizOverviewMapper", quizOverviewMapper),
-		QuizAttemptDetailMapper:       err.PanicIfNil("quizAttemptDetailMapper", quizAttemptDetailMapper),
-		RequestValidator:              err.PanicIfNil("requestValidator", requestValidator),
-		ResponseCreator:               err.PanicIfNil("responseCreator", responseCreator),
+		ParticipantApplicationService:    err.PanicIfNil("participantApplicationService", participantApplicationService),
+		CourseApplicationService:         err.PanicIfNil("courseApplicationService", courseApplicationService),
+		CourseMapper:                     err.PanicIfNil("courseMapper", courseMapper),
+		QuizOverviewMapper:               err.PanicIfNil("quizOverviewMapper", quizOverviewMapper),
+		CommandResultMapper:              err.PanicIfNil("commandResultMapper", commandResultMapper),
+		QuizAttemptDetailMapper:          err.PanicIfNil("quizAttemptDetailMapper", quizAttemptDetailMapper),
+		AttemptReviewMapper:              err.PanicIfNil("attemptReviewMapper", attemptReviewMapper),
+		NextQuestionMapper:               err.PanicIfNil("nextQuestionMapper", nextQuestionMapper),
+		ActiveAttemptMapper:              err.PanicIfNil("activeAttemptMapper", activeAttemptMapper),
+		DueReviewsMapper:                 err.PanicIfNil("dueReviewsMapper", dueReviewsMapper),
+		CourseProgressMapper:             err.PanicIfNil("courseProgressMapper", courseProgressMapper),
+		BadgesMapper:                     err.PanicIfNil("badgesMapper", badgesMapper),
+		ParticipantStatsMapper:           err.PanicIfNil("participantStatsMapper", participantStatsMapper),
+		CertificateApplicationService:    err.PanicIfNil("certificateApplicationService", certificateApplicationService),
+		CertificateMapper:                err.PanicIfNil("certificateMapper", certificateMapper),
+		LeaderboardApplicationService:    err.PanicIfNil("leaderboardApplicationService", leaderboardApplicationService),
+		LeaderboardMapper:                err.PanicIfNil("leaderboardMapper", leaderboardMapper),
+		QuestionRatingApplicationService: err.PanicIfNil("questionRatingApplicationService", questionRatingApplicationService),
+		QuestionStatsApplicationService:  err.PanicIfNil("questionStatsApplicationService", questionStatsApplicationService),
+		ModerationApplicationService:     err.PanicIfNil("moderationApplicationService", moderationApplicationService),
+		ReportsMapper:                    err.PanicIfNil("reportsMapper", reportsMapper),
+		RegradeApplicationService:        err.PanicIfNil("regradeApplicationService", regradeApplicationService),
+		RegradeMapper:                    err.PanicIfNil("regradeMapper", regradeMapper),
+		RequestValidator:                 err.PanicIfNil("requestValidator", requestValidator),
+		ResponseCreator:                  err.PanicIfNil("responseCreator", responseCreator),
 	}
 
 	return registry
@@ -107,14 +107,21 @@ func createTables(dynamoDbClient *dynamodbsdk.Client) {
 			errUtils.PanicIfError(deleteTable(definition.TableName, dynamoDbClient))
 		}
 
+		provisionedThroughput := &types.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(dynamoDbDefaultProvisionedThroughput),
+			WriteCapacityUnits: aws.Int64(dynamoDbDefaultProvisionedThroughput),
+		}
+
+		for i := range definition.GlobalSecondaryIndexes {
+			definition.GlobalSecondaryIndexes[i].ProvisionedThroughput = provisionedThroughput
+		}
+
 		createTableInput := &dynamodbsdk.CreateTableInput{
-			TableName:            aws.String(definition.TableName),
-			KeySchema:            definition.KeySchemas,
-			AttributeDefinitions: definition.AttributeDefinitions,
-			ProvisionedThroughput: &types.ProvisionedThroughput{
-				ReadCapacityUnits:  aws.Int64(dynamoDbDefaultProvisionedThroughput),
-				WriteCapacityUnits: aws.Int64(dynamoDbDefaultProvisionedThroughput),
-			},
+			TableName:              aws.String(definition.TableName),
+			KeySchema:              definition.KeySchemas,
+			AttributeDefinitions:   definition.AttributeDefinitions,
+			GlobalSecondaryIndexes: definition.GlobalSecondaryIndexes,
+			ProvisionedThroughput:  provisionedThroughput,
 		}
 
 		opt := func(o *dynamodbsdk.Options) { o.RetryMaxAttempts = 10 }
@@ -0,0 +1,84 @@
+package participantbuilder
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/clock"
+	"learn-to-code/internal/infrastructure/go/util/err"
+	"time"
+)
+
+// ParticipantID is the id of every built participant.
+const ParticipantID = "participant"
+
+// Builder arranges a participant for tests by applying its commands at the time of a fixed clock, so attempts can
+// happen at any point in time without assembling the events by hand. Every failing command panics.
+type Builder struct {
+	clock       *clock.FixedClock
+	participant participant.Participant
+}
+
+// New creates the participant at the given time.
+func New(createdAt time.Time) *Builder {
+	fixedClock := clock.NewFixedClock(createdAt)
+
+	return &Builder{
+		clock:       fixedClock,
+		participant: err.PanicIfError1(participant.NewParticipantWithClock(ParticipantID, fixedClock)),
+	}
+}
+
+// At applies the following commands at the given time.
+func (b *Builder) At(now time.Time) *Builder {
+	b.clock.Set(now)
+
+	return b
+}
+
+// After applies the following commands the given duration after the previous ones.
+func (b *Builder) After(duration time.Duration) *Builder {
+	return b.At(b.clock.Now().Add(duration))
+}
+
+func (b *Builder) StartQuiz(quizID string, requiredQuestionsAnswered ...string) *Builder {
+	return b.StartQuizWithSettings(quizID, requiredQuestionsAnswered, participant.AttemptSettings{})
+}
+
+func (b *Builder) StartQuizWithSettings(quizID string, requiredQuestionsAnswered []string, settings participant.AttemptSettings) *Builder {
+	err.PanicIfError(b.participant.StartQuizWithSettings(quizID, requiredQuestionsAnswered, settings))
+
+	return b
+}
+
+func (b *Builder) SelectAnswer(quizID string, questionID string, answerID string, isCorrect bool) *Builder {
+	return b.SelectAnswerWithConfidence(quizID, questionID, answerID, isCorrect, "")
+}
+
+func (b *Builder) SelectAnswerWithConfidence(quizID string, questionID string, answerID string, isCorrect bool, confidence string) *Builder {
+	err.PanicIfError(b.participant.SelectQuizAnswerWithConfidence(quizID, questionID, answerID, isCorrect, confidence))
+
+	return b
+}
+
+func (b *Builder) ViewQuestion(quizID string, questionID string) *Builder {
+	err.PanicIfError(b.participant.ViewQuestion(quizID, questionID))
+
+	return b
+}
+
+func (b *Builder) FinishQuiz(quizID string) *Builder {
+	err.PanicIfError(b.participant.FinishQuiz(quizID))
+
+	return b
+}
+
+func (b *Builder) SetTimezone(timezone string) *Builder {
+	err.PanicIfError(b.participant.SetTimezone(timezone))
+
+	return b
+}
+
+// Build returns the participant. It keeps the clock of the builder, so At and After also move the time of the commands
+// applied to the returned participant.
+func (b *Builder) Build() participant.Participant {
+	return b.participant
+}
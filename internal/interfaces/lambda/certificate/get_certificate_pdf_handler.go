@@ -0,0 +1,47 @@
+package certificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetCertificatePdfHandler struct {
+	lambda.HandlerBase
+}
+
+// NewGetCertificatePdfHandler creates the public download of certificates as pdf, it requires no authentication.
+func NewGetCertificatePdfHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetCertificatePdfHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetCertificatePdfHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	certificateID, ok := request.PathParameters["certificateId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("certificateId required"))
+	}
+
+	certificate, c, err := serviceRegistry.CertificateApplicationService.VerifyCertificate(certificateID)
+	if errors.Is(err, participant.ErrCertificateNotFound) || errors.Is(err, participant.ErrInvalidCertificateSignature) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	pdf := serviceRegistry.CertificateMapper.EntityToPdf(certificate, c)
+
+	return serviceRegistry.ResponseCreator.CreatePdfResponse(fmt.Sprintf("certificate-%s.pdf", certificate.CourseID), pdf)
+}
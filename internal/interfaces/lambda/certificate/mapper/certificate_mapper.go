@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/pdf"
+	"learn-to-code/internal/interfaces/lambda/certificate/responseobject"
+)
+
+type CertificateMapper struct {
+}
+
+func NewCertificateMapper() *CertificateMapper {
+	return &CertificateMapper{}
+}
+
+func (cm *CertificateMapper) EntityToResponseObject(certificate participant.Certificate, c course.Course) responseobject.Certificate {
+	return responseobject.Certificate{
+		ID:            certificate.ID,
+		ParticipantID: certificate.ParticipantID,
+		CourseID:      certificate.CourseID,
+		CourseName:    c.Name,
+		CompletedAt:   certificate.CompletedAt,
+		Signature:     certificate.Signature,
+	}
+}
+
+// EntityToPdf renders the certificate as a single landscape page including everything needed to verify it.
+func (cm *CertificateMapper) EntityToPdf(certificate participant.Certificate, c course.Course) []byte {
+	document := pdf.NewLandscapeA4Document()
+
+	document.AddRectangle(30, 30, document.GetWidth()-60, document.GetHeight()-60, 3)
+	document.AddRectangle(40, 40, document.GetWidth()-80, document.GetHeight()-80, 1)
+
+	document.AddCenteredText(460, 40, true, "Certificate of Completion")
+	document.AddCenteredText(400, 16, false, "This certifies that the participant")
+	document.AddCenteredText(370, 14, true, certificate.ParticipantID)
+	document.AddCenteredText(335, 16, false, "successfully passed every step of the course")
+	document.AddCenteredText(290, 28, true, c.Name)
+	document.AddCenteredText(245, 16, false, fmt.Sprintf("Completed on %s", certificate.CompletedAt.UTC().Format("January 2, 2006")))
+
+	document.AddText(60, 110, 10, true, "Certificate ID")
+	document.AddText(160, 110, 10, false, certificate.ID)
+	document.AddText(60, 92, 10, true, "Signature")
+	document.AddText(160, 92, 10, false, certificate.Signature)
+	document.AddText(60, 74, 10, true, "Verify")
+	document.AddText(160, 74, 10, false, fmt.Sprintf("GET /certificates/%s", certificate.ID))
+
+	return document.Bytes()
+}
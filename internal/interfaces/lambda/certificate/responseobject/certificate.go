@@ -0,0 +1,12 @@
+package responseobject
+
+import "time"
+
+type Certificate struct {
+	ID            string    `json:"id"`
+	ParticipantID string    `json:"participantId"`
+	CourseID      string    `json:"courseId"`
+	CourseName    string    `json:"courseName"`
+	CompletedAt   time.Time `json:"completedAt"`
+	Signature     string    `json:"signature"`
+}
@@ -28,7 +28,7 @@ func NewLambdaHandler(cfg config.Config, registryOverride service.RegistryOverri
 func (l *LambdaHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	serviceRegistry := service.NewServiceRegistry(ctx, l.Cfg, l.RegistryOverrides...)
 
-	_, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
 	if err != nil {
 		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
 	}
@@ -38,7 +38,7 @@ func (l *LambdaHandler) HandleRequest(ctx context.Context, request events.APIGat
 		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("courseId required"))
 	}
 
-	resultCourse, err := serviceRegistry.CourseApplicationService.GetCourseByID(courseID)
+	resultCourse, err := serviceRegistry.ParticipantApplicationService.GetCourse(userID, courseID)
 	if errors.Is(err, course.ErrCourseNotFound) {
 		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
 	}
@@ -46,6 +46,16 @@ func (l *LambdaHandler) HandleRequest(ctx context.Context, request events.APIGat
 		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
 	}
 
+	resultCourse, err = serviceRegistry.QuestionRatingApplicationService.AddQuestionRatings(resultCourse)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	resultCourse, err = serviceRegistry.QuestionStatsApplicationService.AddQuestionStats(resultCourse)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
 	responseObject := serviceRegistry.CourseMapper.EntityToResponseObject(resultCourse)
 
 	return serviceRegistry.ResponseCreator.CreateSuccessResponse(responseObject)
@@ -17,33 +17,21 @@ func (cm *CourseMapper) EntityToResponseObject(c course.Course) responseobject.C
 	for _, s := range c.Steps {
 		var responseQuizzes []responseobject.StepQuiz
 		for _, q := range s.Quizzes {
-			var responseQuestions []responseobject.QuizQuestion
-			for _, qq := range q.Questions {
-				var responseAnswers []responseobject.QuizAnswer
-				for _, a := range qq.Answers {
-					responseAnswers = append(responseAnswers, responseobject.QuizAnswer{
-						ID:          a.ID,
-						Text:        a.Text,
-						IsCorrect:   a.IsCorrect,
-						Description: a.Description,
-					})
-				}
-				responseQuestions = append(responseQuestions, responseobject.QuizQuestion{
-					ID:         qq.ID,
-					Text:       qq.Text,
-					Difficulty: qq.Difficulty,
-					Answers:    responseAnswers,
-				})
-			}
-			responseQuizzes = append(responseQuizzes, responseobject.StepQuiz{
-				ID:        q.ID,
-				Questions: responseQuestions,
+			responseQuizzes = append(responseQuizzes, cm.QuizToResponseObject(q))
+		}
+		var responsePrerequisites []responseobject.Prerequisite
+		for _, p := range s.Prerequisites {
+			responsePrerequisites = append(responsePrerequisites, responseobject.Prerequisite{
+				StepIDs:        p.StepIDs,
+				MinPassedSteps: p.MinPassedSteps,
 			})
 		}
 		responseSteps = append(responseSteps, responseobject.Step{
-			ID:      s.ID,
-			Quizzes: responseQuizzes,
-			Name:    s.Name,
+			ID:            s.ID,
+			Quizzes:       responseQuizzes,
+			Name:          s.Name,
+			Prerequisites: responsePrerequisites,
+			Locked:        s.Locked,
 		})
 	}
 
@@ -53,3 +41,49 @@ func (cm *CourseMapper) EntityToResponseObject(c course.Course) responseobject.C
 		Name:  c.Name,
 	}
 }
+
+func (cm *CourseMapper) QuizToResponseObject(q course.StepQuiz) responseobject.StepQuiz {
+	var responseQuestions []responseobject.QuizQuestion
+	for _, qq := range q.Questions {
+		responseQuestions = append(responseQuestions, cm.QuestionToResponseObject(qq))
+	}
+
+	return responseobject.StepQuiz{
+		ID:                  q.ID,
+		Questions:           responseQuestions,
+		DurationMins:        q.DurationMins,
+		MaxAttempts:         q.MaxAttempts,
+		CooldownMins:        q.CooldownMins,
+		QuestionsPerAttempt: q.QuestionsPerAttempt,
+		DifficultyQuotas:    q.DifficultyQuotas,
+		Adaptive:            q.Adaptive,
+		FeedbackDisabled:    q.FeedbackDisabled,
+		HintPenalty:         q.HintPenalty,
+	}
+}
+
+func (cm *CourseMapper) QuestionToResponseObject(qq course.QuizQuestion) responseobject.QuizQuestion {
+	var responseAnswers []responseobject.QuizAnswer
+	for _, a := range qq.Answers {
+		responseAnswers = append(responseAnswers, responseobject.QuizAnswer{
+			ID:   a.ID,
+			Text: a.Text,
+		})
+	}
+
+	return responseobject.QuizQuestion{
+		ID:          qq.ID,
+		Text:        qq.Text,
+		Difficulty:  qq.Difficulty,
+		Answers:     responseAnswers,
+		Rating:      float32(qq.Rating),
+		RatingCount: qq.RatingCount,
+		HintCount:   len(qq.Hints),
+
+		AnswerCount:     qq.AnswerCount,
+		CorrectRatio:    qq.CorrectRatio,
+		AverageTimeSecs: qq.AverageTimeSecs,
+		MedianTimeSecs:  qq.MedianTimeSecs,
+		P90TimeSecs:     qq.P90TimeSecs,
+	}
+}
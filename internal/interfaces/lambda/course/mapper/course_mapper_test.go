@@ -4,6 +4,8 @@ import (
 	"learn-to-code/internal/domain/quiz/course"
 	"learn-to-code/internal/infrastructure/testing/assertgo"
 	"learn-to-code/internal/interfaces/lambda/course/mapper"
+	"learn-to-code/internal/interfaces/lambda/course/responseobject"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -24,8 +26,15 @@ func TestEntityToResponseObject(t *testing.T) {
 								ID:          "16254bde-ac8c-409e-8a3e-f10805151a6b",
 								Text:        "questionText",
 								Difficulty:  "easy",
-								Rating:      0,
-								RatingCount: 0,
+								Rating:      3.5,
+								RatingCount: 2,
+								Hints:       []string{"hint"},
+
+								AnswerCount:     4,
+								CorrectRatio:    0.75,
+								AverageTimeSecs: 42.5,
+								MedianTimeSecs:  40,
+								P90TimeSecs:     60,
 								Answers: []course.QuizAnswer{
 									{
 										ID:          "c362e4d9-f915-4480-bec0-488258e07186",
@@ -45,5 +54,35 @@ func TestEntityToResponseObject(t *testing.T) {
 	cm := mapper.NewCourseMapper()
 	resObj := cm.EntityToResponseObject(c)
 
+	responseAnswers := resObj.Steps[0].Quizzes[0].Questions[0].Answers
+	expectedAnswer := responseobject.QuizAnswer{ID: "c362e4d9-f915-4480-bec0-488258e07186", Text: "answerText"}
+	if len(responseAnswers) != 1 || responseAnswers[0] != expectedAnswer {
+		t.Fatalf("expected the answer without answer key %v, got %v", expectedAnswer, responseAnswers)
+	}
+
+	responseQuestion := resObj.Steps[0].Quizzes[0].Questions[0]
+	responseQuestion.Answers = nil
+	expectedQuestion := responseobject.QuizQuestion{
+		ID:          "16254bde-ac8c-409e-8a3e-f10805151a6b",
+		Text:        "questionText",
+		Difficulty:  "easy",
+		Rating:      3.5,
+		RatingCount: 2,
+		HintCount:   1,
+
+		AnswerCount:     4,
+		CorrectRatio:    0.75,
+		AverageTimeSecs: 42.5,
+		MedianTimeSecs:  40,
+		P90TimeSecs:     60,
+	}
+	if !reflect.DeepEqual(responseQuestion, expectedQuestion) {
+		t.Fatalf("expected the question with the number of hints but without the hints %v, got %v", expectedQuestion, responseQuestion)
+	}
+
+	// the questions are compared above, the response omits the answer key and the hints
+	c.Steps[0].Quizzes[0].Questions = nil
+	resObj.Steps[0].Quizzes[0].Questions = nil
+
 	assertgo.NewAssertion(t, c).WithTypeKeyManipulation(strings.ToLower).IsEqualTo(resObj)
 }
@@ -1,8 +1,10 @@
 package responseobject
 
+// QuizAnswer is also read from the static course files. The course mapper never sets the answer key, the correctness
+// and the explanation are only part of the attempt review.
 type QuizAnswer struct {
 	ID          string `json:"id"`
 	Text        string `json:"text"`
-	IsCorrect   bool   `json:"isCorrect"`
-	Description string `json:"description"`
+	IsCorrect   bool   `json:"isCorrect,omitempty"`
+	Description string `json:"description,omitempty"`
 }
@@ -7,4 +7,14 @@ type QuizQuestion struct {
 	Answers     []QuizAnswer `json:"answers"`
 	Rating      float32      `json:"rating"`
 	RatingCount int          `json:"ratingCount"`
+	HintCount   int          `json:"hintCount"`
+
+	AnswerCount     int     `json:"answerCount"`
+	CorrectRatio    float64 `json:"correctRatio"`
+	AverageTimeSecs float64 `json:"averageTimeSecs"`
+	MedianTimeSecs  float64 `json:"medianTimeSecs"`
+	P90TimeSecs     float64 `json:"p90TimeSecs"`
+
+	// Hints are only read from the quiz definition, the course response contains the number of hints.
+	Hints []string `json:"hints,omitempty"`
 }
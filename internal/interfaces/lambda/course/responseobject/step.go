@@ -1,7 +1,14 @@
 package responseobject
 
 type Step struct {
-	ID      string     `json:"id"`
-	Quizzes []StepQuiz `json:"quizzes"`
-	Name    string     `json:"name"`
+	ID            string         `json:"id"`
+	Quizzes       []StepQuiz     `json:"quizzes"`
+	Name          string         `json:"name"`
+	Prerequisites []Prerequisite `json:"prerequisites"`
+	Locked        bool           `json:"locked"`
+}
+
+type Prerequisite struct {
+	StepIDs        []string `json:"stepIds"`
+	MinPassedSteps int      `json:"minPassedSteps"`
 }
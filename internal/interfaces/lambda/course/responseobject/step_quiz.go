@@ -1,6 +1,15 @@
 package responseobject
 
 type StepQuiz struct {
-	ID        string         `json:"id"`
-	Questions []QuizQuestion `json:"questions"`
+	ID           string         `json:"id"`
+	Questions    []QuizQuestion `json:"questions"`
+	DurationMins int            `json:"durationMins"`
+	MaxAttempts  int            `json:"maxAttempts"`
+	CooldownMins int            `json:"cooldownMins"`
+
+	QuestionsPerAttempt int            `json:"questionsPerAttempt"`
+	DifficultyQuotas    map[string]int `json:"difficultyQuotas"`
+	Adaptive            bool           `json:"adaptive"`
+	FeedbackDisabled    bool           `json:"feedbackDisabled"`
+	HintPenalty         float64        `json:"hintPenalty"`
 }
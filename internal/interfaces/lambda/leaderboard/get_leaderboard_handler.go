@@ -0,0 +1,91 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+	"strconv"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetLeaderboardHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetLeaderboardHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetLeaderboardHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetLeaderboardHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	courseID, ok := request.PathParameters["courseId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("courseId required"))
+	}
+
+	period, ok := request.PathParameters["period"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("period required"))
+	}
+
+	metric := request.QueryStringParameters["metric"]
+	if metric == "" {
+		metric = string(leaderboard.MetricExperiencePoints)
+	}
+
+	page, err := getOptionalIntQueryParameter(request, "page", 1)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	if page < 1 {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("page must be at least 1"))
+	}
+
+	pageSize, err := getOptionalIntQueryParameter(request, "pageSize", leaderboard.DefaultPageSize)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	ranking, err := serviceRegistry.LeaderboardApplicationService.GetLeaderboard(courseID, period, metric, page, pageSize)
+	if errors.Is(err, course.ErrCourseNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if errors.Is(err, leaderboard.ErrUnknownPeriod) || errors.Is(err, leaderboard.ErrUnknownMetric) {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.LeaderboardMapper.EntityToResponseObject(ranking, userID))
+}
+
+func getOptionalIntQueryParameter(request events.APIGatewayProxyRequest, name string, defaultValue int) (int, error) {
+	value, ok := request.QueryStringParameters[name]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%v must be a number", name)
+	}
+
+	return intValue, nil
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/leaderboard"
+	"learn-to-code/internal/interfaces/lambda/leaderboard/responseobject"
+)
+
+type LeaderboardMapper struct {
+}
+
+func NewLeaderboardMapper() *LeaderboardMapper {
+	return &LeaderboardMapper{}
+}
+
+// EntityToResponseObject marks the entry of the requesting participant, so it can be highlighted.
+func (lm *LeaderboardMapper) EntityToResponseObject(ranking leaderboard.Ranking, participantID string) responseobject.Leaderboard {
+	entries := make([]responseobject.LeaderboardEntry, 0, len(ranking.Entries))
+	for _, rankedEntry := range ranking.Entries {
+		entries = append(entries, responseobject.LeaderboardEntry{
+			Rank:                 rankedEntry.Rank,
+			ParticipantID:        rankedEntry.ParticipantID,
+			Score:                rankedEntry.Score,
+			IsCurrentParticipant: rankedEntry.ParticipantID == participantID,
+		})
+	}
+
+	return responseobject.Leaderboard{
+		CourseID:     ranking.CourseID,
+		Period:       ranking.Period,
+		Metric:       string(ranking.Metric),
+		Page:         ranking.Page,
+		PageSize:     ranking.PageSize,
+		TotalEntries: ranking.TotalEntries,
+		Entries:      entries,
+	}
+}
@@ -0,0 +1,18 @@
+package responseobject
+
+type Leaderboard struct {
+	CourseID     string             `json:"courseId"`
+	Period       string             `json:"period"`
+	Metric       string             `json:"metric"`
+	Page         int                `json:"page"`
+	PageSize     int                `json:"pageSize"`
+	TotalEntries int                `json:"totalEntries"`
+	Entries      []LeaderboardEntry `json:"entries"`
+}
+
+type LeaderboardEntry struct {
+	Rank                 int    `json:"rank"`
+	ParticipantID        string `json:"participantId"`
+	Score                int    `json:"score"`
+	IsCurrentParticipant bool   `json:"isCurrentParticipant"`
+}
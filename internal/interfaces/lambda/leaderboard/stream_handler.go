@@ -0,0 +1,70 @@
+package leaderboard
+
+import (
+	"context"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// scoreChangingEventTypes are the stored events that change the leaderboard entries of a participant.
+var scoreChangingEventTypes = map[string]bool{
+	event.FinishedQuizTypeName:         true,
+	event.QuizTimedOutTypeName:         true,
+	event.AnswerRegradedTypeName:       true,
+	event.ReviewedQuestionTypeName:     true,
+	event.LeaderboardOptOutSetTypeName: true,
+}
+
+// StreamHandler keeps the leaderboards up to date with the events table. It is invoked by the DynamoDB stream of
+// the events table and updates every participant with new score changing events once per batch.
+type StreamHandler struct {
+	lambda.HandlerBase
+}
+
+func NewStreamHandler(cfg config.Config, registryOverride service.RegistryOverride) *StreamHandler {
+	return &StreamHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (sh *StreamHandler) HandleEvent(ctx context.Context, streamEvent events.DynamoDBEvent) error {
+	serviceRegistry := service.NewServiceRegistry(ctx, sh.Cfg, sh.RegistryOverrides...)
+
+	var participantIDs []string
+	seenParticipantIDs := map[string]bool{}
+
+	for _, record := range streamEvent.Records {
+		if record.EventName != string(events.DynamoDBOperationTypeInsert) {
+			continue
+		}
+
+		eventType, ok := record.Change.NewImage["type"]
+		if !ok || !scoreChangingEventTypes[eventType.String()] {
+			continue
+		}
+
+		aggregateID, ok := record.Change.NewImage["aggregate_id"]
+		if !ok || seenParticipantIDs[aggregateID.String()] {
+			continue
+		}
+
+		seenParticipantIDs[aggregateID.String()] = true
+		participantIDs = append(participantIDs, aggregateID.String())
+	}
+
+	for _, participantID := range participantIDs {
+		err := serviceRegistry.LeaderboardApplicationService.UpdateParticipantEntries(participantID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/infrastructure/config"
+	lambda2 "learn-to-code/internal/infrastructure/lambda"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetReportsHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetReportsHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetReportsHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetReportsHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	_, err := serviceRegistry.RequestValidator.ValidateAdminRequest(request)
+	if errors.Is(err, lambda2.ErrNotAdmin) {
+		return serviceRegistry.ResponseCreator.CreateForbiddenResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	status := request.QueryStringParameters["status"]
+	if status == "" {
+		status = string(moderation.StatusOpen)
+	}
+
+	reports, err := serviceRegistry.ModerationApplicationService.GetReports(status)
+	if errors.Is(err, moderation.ErrUnknownStatus) {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.ReportsMapper.EntityToResponseObject(status, reports))
+}
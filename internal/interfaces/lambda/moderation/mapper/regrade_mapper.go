@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/interfaces/lambda/moderation/responseobject"
+)
+
+type RegradeMapper struct {
+}
+
+func NewRegradeMapper() *RegradeMapper {
+	return &RegradeMapper{}
+}
+
+func (rm *RegradeMapper) EntityToResponseObject(courseID string, dryRun bool, regrades []participant.Regrade, nextParticipantID string) responseobject.Regrade {
+	participantRegrades := []responseobject.ParticipantRegrade{}
+
+	for _, regrade := range regrades {
+		answerRegrades := []responseobject.AnswerRegrade{}
+		for _, answerRegrade := range regrade.Answers {
+			answerRegrades = append(answerRegrades, responseobject.AnswerRegrade{
+				QuizID:     answerRegrade.QuizID,
+				AttemptID:  answerRegrade.AttemptID,
+				QuestionID: answerRegrade.QuestionID,
+				AnswerID:   answerRegrade.AnswerID,
+				WasCorrect: answerRegrade.WasCorrect,
+				IsCorrect:  answerRegrade.IsCorrect,
+			})
+		}
+
+		attemptRegrades := []responseobject.AttemptRegrade{}
+		for _, attemptRegrade := range regrade.Attempts {
+			attemptRegrades = append(attemptRegrades, responseobject.AttemptRegrade{
+				QuizID:                  attemptRegrade.QuizID,
+				AttemptID:               attemptRegrade.AttemptID,
+				WasPass:                 attemptRegrade.WasPass,
+				Pass:                    attemptRegrade.IsPass,
+				WasQuestionCorrectRatio: attemptRegrade.WasCorrectRatio,
+				QuestionCorrectRatio:    attemptRegrade.CorrectRatio,
+			})
+		}
+
+		participantRegrades = append(participantRegrades, responseobject.ParticipantRegrade{
+			ParticipantID: regrade.ParticipantID,
+			Answers:       answerRegrades,
+			Attempts:      attemptRegrades,
+		})
+	}
+
+	return responseobject.Regrade{
+		CourseID:          courseID,
+		DryRun:            dryRun,
+		Participants:      participantRegrades,
+		NextParticipantID: nextParticipantID,
+	}
+}
@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/interfaces/lambda/moderation/responseobject"
+)
+
+type ReportsMapper struct {
+}
+
+func NewReportsMapper() *ReportsMapper {
+	return &ReportsMapper{}
+}
+
+func (rm *ReportsMapper) EntityToResponseObject(status string, reports []moderation.Report) responseobject.Reports {
+	responseReports := []responseobject.Report{}
+
+	for _, report := range reports {
+		responseReports = append(responseReports, responseobject.Report{
+			ID:             report.ID,
+			ParticipantID:  report.ParticipantID,
+			QuizID:         report.QuizID,
+			QuestionID:     report.QuestionID,
+			Category:       report.Category,
+			Text:           report.Text,
+			ReportedAt:     report.ReportedAt,
+			Status:         string(report.Status),
+			DecidedAt:      report.DecidedAt,
+			ContentVersion: report.ContentVersion,
+			RejectReason:   report.RejectReason,
+		})
+	}
+
+	return responseobject.Reports{
+		Status:  status,
+		Reports: responseReports,
+	}
+}
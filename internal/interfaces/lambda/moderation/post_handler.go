@@ -0,0 +1,63 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/infrastructure/config"
+	lambda2 "learn-to-code/internal/infrastructure/lambda"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+	"learn-to-code/internal/interfaces/lambda/course/requestobject"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// PostHandler applies the decisions of moderators to the moderation queue, i.e. the commands ResolveQuestionIssue
+// and RejectQuestionIssue.
+type PostHandler struct {
+	lambda.HandlerBase
+}
+
+func NewPostModerationCommandHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &PostHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (ph *PostHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, ph.Cfg, ph.RegistryOverrides...)
+
+	_, err := serviceRegistry.RequestValidator.ValidateAdminRequest(request)
+	if errors.Is(err, lambda2.ErrNotAdmin) {
+		return serviceRegistry.ResponseCreator.CreateForbiddenResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	commandRequest, err := lambda2.ReadBody(request.Body, requestobject.Command{})
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	commandDomainObject := command.Command{
+		CreatedAt: commandRequest.CreatedAt,
+		Data:      commandRequest.Data,
+		Type:      commandRequest.Type,
+	}
+
+	err = serviceRegistry.ModerationApplicationService.ProcessCommand(commandDomainObject)
+	if errors.Is(err, moderation.ErrReportNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if errors.Is(err, moderation.ErrReportAlreadyDecided) || errors.Is(err, moderation.ErrMissingContentVersion) {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(commandDomainObject)
+}
@@ -0,0 +1,54 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/infrastructure/config"
+	lambda2 "learn-to-code/internal/infrastructure/lambda"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+	"learn-to-code/internal/interfaces/lambda/moderation/requestobject"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// RegradeHandler regrades the answers of a page of participants after the answer key of a course was corrected, a dry
+// run returns the report without changing any participant.
+type RegradeHandler struct {
+	lambda.HandlerBase
+}
+
+func NewRegradeHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &RegradeHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (rh *RegradeHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, rh.Cfg, rh.RegistryOverrides...)
+
+	_, err := serviceRegistry.RequestValidator.ValidateAdminRequest(request)
+	if errors.Is(err, lambda2.ErrNotAdmin) {
+		return serviceRegistry.ResponseCreator.CreateForbiddenResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	regradeRequest, err := lambda2.ReadBody(request.Body, requestobject.Regrade{})
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	regrades, nextParticipantID, err := serviceRegistry.RegradeApplicationService.RegradeCourse(regradeRequest.CourseID, *regradeRequest.DryRun, regradeRequest.AfterParticipantID)
+	if errors.Is(err, course.ErrCourseNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.RegradeMapper.EntityToResponseObject(regradeRequest.CourseID, *regradeRequest.DryRun, regrades, nextParticipantID))
+}
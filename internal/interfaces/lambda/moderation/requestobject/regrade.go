@@ -0,0 +1,23 @@
+package requestobject
+
+import "errors"
+
+// Regrade requires an explicit dryRun, the answers are only regraded with dryRun set to false. Without
+// afterParticipantId the regrade starts with the first page of participants.
+type Regrade struct {
+	CourseID           string `json:"courseId"`
+	DryRun             *bool  `json:"dryRun"`
+	AfterParticipantID string `json:"afterParticipantId"`
+}
+
+func (r Regrade) Validate() error {
+	if r.CourseID == "" {
+		return errors.New("course id cannot be empty")
+	}
+
+	if r.DryRun == nil {
+		return errors.New("dry run must be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package responseobject
+
+// Regrade covers a page of participants, the regrade continues with nextParticipantId as afterParticipantId until it
+// is omitted after the last page.
+type Regrade struct {
+	CourseID          string               `json:"courseId"`
+	DryRun            bool                 `json:"dryRun"`
+	Participants      []ParticipantRegrade `json:"participants"`
+	NextParticipantID string               `json:"nextParticipantId,omitempty"`
+}
+
+type ParticipantRegrade struct {
+	ParticipantID string           `json:"participantId"`
+	Answers       []AnswerRegrade  `json:"answers"`
+	Attempts      []AttemptRegrade `json:"attempts"`
+}
+
+type AnswerRegrade struct {
+	QuizID     string `json:"quizId"`
+	AttemptID  int    `json:"attemptId"`
+	QuestionID string `json:"questionId"`
+	AnswerID   string `json:"answerId"`
+	WasCorrect bool   `json:"wasCorrect"`
+	IsCorrect  bool   `json:"isCorrect"`
+}
+
+type AttemptRegrade struct {
+	QuizID                  string  `json:"quizId"`
+	AttemptID               int     `json:"attemptId"`
+	WasPass                 bool    `json:"wasPass"`
+	Pass                    bool    `json:"pass"`
+	WasQuestionCorrectRatio float64 `json:"wasQuestionCorrectRatio"`
+	QuestionCorrectRatio    float64 `json:"questionCorrectRatio"`
+}
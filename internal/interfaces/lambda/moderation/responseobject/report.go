@@ -0,0 +1,22 @@
+package responseobject
+
+import "time"
+
+type Reports struct {
+	Status  string   `json:"status"`
+	Reports []Report `json:"reports"`
+}
+
+type Report struct {
+	ID             string    `json:"id"`
+	ParticipantID  string    `json:"participantId"`
+	QuizID         string    `json:"quizId"`
+	QuestionID     string    `json:"questionId"`
+	Category       string    `json:"category"`
+	Text           string    `json:"text"`
+	ReportedAt     time.Time `json:"reportedAt"`
+	Status         string    `json:"status"`
+	DecidedAt      time.Time `json:"decidedAt"`
+	ContentVersion string    `json:"contentVersion"`
+	RejectReason   string    `json:"rejectReason"`
+}
@@ -0,0 +1,61 @@
+package moderation
+
+import (
+	"context"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// StreamHandler keeps the moderation queue up to date with the events table. It is invoked by the DynamoDB stream of
+// the events table and queues the new question issue reports of every participant once per batch.
+type StreamHandler struct {
+	lambda.HandlerBase
+}
+
+func NewStreamHandler(cfg config.Config, registryOverride service.RegistryOverride) *StreamHandler {
+	return &StreamHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (sh *StreamHandler) HandleEvent(ctx context.Context, streamEvent events.DynamoDBEvent) error {
+	serviceRegistry := service.NewServiceRegistry(ctx, sh.Cfg, sh.RegistryOverrides...)
+
+	var participantIDs []string
+	seenParticipantIDs := map[string]bool{}
+
+	for _, record := range streamEvent.Records {
+		if record.EventName != string(events.DynamoDBOperationTypeInsert) {
+			continue
+		}
+
+		eventType, ok := record.Change.NewImage["type"]
+		if !ok || eventType.String() != event.QuestionIssueReportedTypeName {
+			continue
+		}
+
+		aggregateID, ok := record.Change.NewImage["aggregate_id"]
+		if !ok || seenParticipantIDs[aggregateID.String()] {
+			continue
+		}
+
+		seenParticipantIDs[aggregateID.String()] = true
+		participantIDs = append(participantIDs, aggregateID.String())
+	}
+
+	for _, participantID := range participantIDs {
+		err := serviceRegistry.ModerationApplicationService.QueueParticipantReports(participantID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package badge
+
+import (
+	"context"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetBadgesHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetParticipantBadgesHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetBadgesHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetBadgesHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	awardedBadges, err := serviceRegistry.ParticipantApplicationService.GetBadges(userID)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.BadgesMapper.EntityToResponseObject(awardedBadges))
+}
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/badge"
+	"learn-to-code/internal/interfaces/lambda/participant/badge/responseobject"
+)
+
+type BadgesMapper struct {
+}
+
+func NewBadgesMapper() *BadgesMapper {
+	return &BadgesMapper{}
+}
+
+func (bm *BadgesMapper) EntityToResponseObject(awardedBadges []badge.AwardedBadge) responseobject.Badges {
+	badges := []responseobject.AwardedBadge{}
+
+	for _, awardedBadge := range awardedBadges {
+		badges = append(badges, responseobject.AwardedBadge{
+			CourseID:    awardedBadge.CourseID,
+			BadgeID:     awardedBadge.BadgeID,
+			Name:        awardedBadge.Name,
+			Description: awardedBadge.Description,
+			AwardedAt:   awardedBadge.AwardedAt,
+		})
+	}
+
+	return responseobject.Badges{
+		Badges: badges,
+	}
+}
@@ -0,0 +1,15 @@
+package responseobject
+
+import "time"
+
+type Badges struct {
+	Badges []AwardedBadge `json:"badges"`
+}
+
+type AwardedBadge struct {
+	CourseID    string    `json:"courseId"`
+	BadgeID     string    `json:"badgeId"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	AwardedAt   time.Time `json:"awardedAt"`
+}
@@ -2,8 +2,12 @@ package participant
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	command "learn-to-code/internal/domain/command"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/moderation"
+	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/infrastructure/config"
 	"learn-to-code/internal/infrastructure/lambda"
 	"learn-to-code/internal/infrastructure/service"
@@ -41,12 +45,47 @@ func (l LambdaHandler) HandleRequest(ctx context.Context, request events.APIGate
 
 	commandDomainObject := l.mapRequestToCommand(commandRequest)
 
-	err = serviceRegistry.ParticipantApplicationService.ProcessCommand(commandDomainObject, userID)
-	if err != nil {
+	result, err := serviceRegistry.ParticipantApplicationService.ProcessCommand(commandDomainObject, userID)
+	if errors.Is(err, participant.ErrMaxAttemptsReached) || errors.Is(err, participant.ErrCooldownActive) || errors.Is(err, participant.ErrTimeLimitExceeded) {
+		return serviceRegistry.ResponseCreator.CreateConflictResponse(err)
+	} else if errors.Is(err, participant.ErrStepLocked) {
+		return serviceRegistry.ResponseCreator.CreateForbiddenResponse(err)
+	} else if errors.Is(err, participant.ErrQuizAlreadyStarted) || errors.Is(err, participant.ErrQuestionAlreadyAnswered) || errors.Is(err, participant.ErrAdaptiveAttemptNotSettled) || errors.Is(err, participant.ErrReviewNotDue) {
+		return serviceRegistry.ResponseCreator.CreateConflictResponse(err)
+	} else if errors.Is(err, participant.ErrNoWrongAnswers) || errors.Is(err, participant.ErrNoHintLeft) {
+		return serviceRegistry.ResponseCreator.CreateConflictResponse(err)
+	} else if isInvalidCommandData(err) {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	} else if err != nil {
 		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
 	}
 
-	return serviceRegistry.ResponseCreator.CreateSuccessResponse(commandDomainObject)
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.CommandResultMapper.EntityToResponseObject(result))
+}
+
+// isInvalidCommandData returns true if the command refers to unknown content or carries values the participant can not
+// accept.
+func isInvalidCommandData(err error) bool {
+	invalidCommandDataErrors := []error{
+		participant.ErrNotNextAdaptiveQuestion,
+		participant.ErrInvalidReviewQuality,
+		participant.ErrUnknownConfidence,
+		participant.ErrInvalidQuestionRating,
+		participant.ErrInvalidTimezone,
+		participant.ErrUnknownAttemptMode,
+		moderation.ErrUnknownIssueCategory,
+		moderation.ErrInvalidIssueText,
+		course.ErrQuizNotFound,
+		course.ErrQuestionNotFound,
+	}
+
+	for _, invalidCommandDataError := range invalidCommandDataErrors {
+		if errors.Is(err, invalidCommandDataError) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (l LambdaHandler) mapRequestToCommand(commandRequest requestobject.Command) command.Command {
@@ -0,0 +1,49 @@
+package progress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetCourseProgressHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetParticipantCourseProgressHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetCourseProgressHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetCourseProgressHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	courseID, ok := request.PathParameters["courseId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("courseId required"))
+	}
+
+	courseProgress, err := serviceRegistry.ParticipantApplicationService.GetCourseProgress(userID, courseID)
+	if errors.Is(err, course.ErrCourseNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.CourseProgressMapper.EntityToResponseObject(courseProgress))
+}
@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/projection/courseprogress"
+	"learn-to-code/internal/interfaces/lambda/participant/progress/responseobject"
+)
+
+type CourseProgressMapper struct {
+}
+
+func NewCourseProgressMapper() *CourseProgressMapper {
+	return &CourseProgressMapper{}
+}
+
+func (cm *CourseProgressMapper) EntityToResponseObject(cp courseprogress.CourseProgress) responseobject.CourseProgress {
+	return responseobject.CourseProgress{
+		CourseID:              cp.CourseID,
+		CompletedStepIDs:      cp.CompletedStepIDs,
+		CurrentStepID:         cp.CurrentStepID,
+		NextRecommendedQuizID: cp.NextRecommendedQuizID,
+		CompletionPercentage:  cp.CompletionPercentage,
+		LastActivityAt:        cp.LastActivityAt,
+		CertificateID:         cp.CertificateID,
+	}
+}
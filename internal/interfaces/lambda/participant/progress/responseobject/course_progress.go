@@ -0,0 +1,13 @@
+package responseobject
+
+import "time"
+
+type CourseProgress struct {
+	CourseID              string    `json:"courseId"`
+	CompletedStepIDs      []string  `json:"completedStepIds"`
+	CurrentStepID         string    `json:"currentStepId"`
+	NextRecommendedQuizID string    `json:"nextRecommendedQuizId"`
+	CompletionPercentage  int       `json:"completionPercentage"`
+	LastActivityAt        time.Time `json:"lastActivityAt"`
+	CertificateID         string    `json:"certificateId"`
+}
@@ -0,0 +1,50 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetActiveAttemptHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetParticipantActiveAttemptHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetActiveAttemptHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetActiveAttemptHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	quizID, ok := request.PathParameters["quizId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("quizId required"))
+	}
+
+	activeAttempt, err := serviceRegistry.ParticipantApplicationService.GetActiveAttempt(userID, quizID)
+	if errors.Is(err, participant.ErrNoActiveAttempt) || errors.Is(err, course.ErrQuizNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.ActiveAttemptMapper.EntityToResponseObject(activeAttempt))
+}
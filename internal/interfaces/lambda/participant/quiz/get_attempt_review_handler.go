@@ -0,0 +1,55 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetAttemptReviewHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetAttemptReviewHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetAttemptReviewHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetAttemptReviewHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	quizID, ok := request.PathParameters["quizId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("quizId required"))
+	}
+
+	attemptID, ok := request.PathParameters["attemptId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("attemptId required"))
+	}
+
+	attemptReview, err := serviceRegistry.ParticipantApplicationService.GetAttemptReview(userID, quizID, attemptID)
+	if errors.As(err, &quizattemptdetail.AttemptNotFoundError{}) || errors.Is(err, course.ErrQuizNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.AttemptReviewMapper.EntityToResponseObject(attemptReview))
+}
@@ -0,0 +1,52 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"learn-to-code/internal/domain/quiz/course"
+	"learn-to-code/internal/domain/quiz/participant"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetNextQuestionHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetParticipantNextQuestionHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetNextQuestionHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetNextQuestionHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	quizID, ok := request.PathParameters["quizId"]
+	if !ok {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(fmt.Errorf("quizId required"))
+	}
+
+	nextQuestion, err := serviceRegistry.ParticipantApplicationService.GetNextAdaptiveQuestion(userID, quizID)
+	if errors.Is(err, participant.ErrNoActiveAttempt) || errors.Is(err, course.ErrQuizNotFound) {
+		return serviceRegistry.ResponseCreator.CreateNotFoundResponse()
+	} else if errors.Is(err, participant.ErrAttemptNotAdaptive) {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	} else if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.NextQuestionMapper.EntityToResponseObject(nextQuestion))
+}
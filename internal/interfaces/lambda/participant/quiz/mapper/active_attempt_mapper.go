@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/projection/activeattempt"
+	coursemapper "learn-to-code/internal/interfaces/lambda/course/mapper"
+	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
+)
+
+type ActiveAttemptMapper struct {
+	courseMapper *coursemapper.CourseMapper
+}
+
+func NewActiveAttemptMapper(courseMapper *coursemapper.CourseMapper) *ActiveAttemptMapper {
+	return &ActiveAttemptMapper{
+		courseMapper: courseMapper,
+	}
+}
+
+func (am *ActiveAttemptMapper) EntityToResponseObject(aa activeattempt.ActiveAttempt) responseobject.ActiveAttempt {
+	responseQuestions := []responseobject.ResumedQuestion{}
+
+	for _, resumedQuestion := range aa.Questions {
+		usedHints := []string{}
+		usedHints = append(usedHints, resumedQuestion.UsedHints...)
+
+		responseQuestions = append(responseQuestions, responseobject.ResumedQuestion{
+			Question:         am.courseMapper.QuestionToResponseObject(resumedQuestion.Question),
+			SelectedAnswerID: resumedQuestion.SelectedAnswerID,
+			Confidence:       resumedQuestion.Confidence,
+			UsedHints:        usedHints,
+		})
+	}
+
+	return responseobject.ActiveAttempt{
+		QuizID:            aa.QuizID,
+		AttemptID:         aa.AttemptID,
+		StartedAt:         aa.StartedAt,
+		Adaptive:          aa.Adaptive,
+		Practice:          aa.Practice,
+		RetakeOfAttemptID: aa.RetakeOfAttemptID,
+		Questions:         responseQuestions,
+		NextQuestionID:    aa.NextQuestionID,
+		Timed:             aa.Timed,
+		RemainingTimeSecs: aa.RemainingTimeSecs,
+		UsedHintCount:     aa.UsedHintCount,
+		HintPenalty:       aa.HintPenalty,
+	}
+}
@@ -0,0 +1,85 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/projection/attemptreview"
+	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
+)
+
+type AttemptReviewMapper struct {
+}
+
+func NewAttemptReviewMapper() *AttemptReviewMapper {
+	return &AttemptReviewMapper{}
+}
+
+func (am *AttemptReviewMapper) EntityToResponseObject(ar attemptreview.AttemptReview) responseobject.AttemptReview {
+	responseQuestions := []responseobject.ReviewedQuestion{}
+
+	for _, reviewedQuestion := range ar.Questions {
+		responseAnswers := []responseobject.ReviewedAnswer{}
+		var correctAnswerIDs []string
+		explanation := ""
+		for _, answer := range reviewedQuestion.Question.Answers {
+			responseAnswer := responseobject.ReviewedAnswer{
+				ID:   answer.ID,
+				Text: answer.Text,
+			}
+
+			if reviewedQuestion.Revealed {
+				isCorrect := answer.IsCorrect
+				responseAnswer.IsCorrect = &isCorrect
+				responseAnswer.Description = answer.Description
+
+				if answer.IsCorrect {
+					correctAnswerIDs = append(correctAnswerIDs, answer.ID)
+				}
+
+				if answer.ID == reviewedQuestion.SelectedAnswerID {
+					explanation = answer.Description
+				}
+			}
+
+			responseAnswers = append(responseAnswers, responseAnswer)
+		}
+
+		selectedAnswerIDs := []string{}
+		selectedAnswerIDs = append(selectedAnswerIDs, reviewedQuestion.SelectedAnswerIDs...)
+
+		usedHints := []string{}
+		usedHints = append(usedHints, reviewedQuestion.UsedHints...)
+
+		responseQuestions = append(responseQuestions, responseobject.ReviewedQuestion{
+			ID:                reviewedQuestion.Question.ID,
+			Text:              reviewedQuestion.Question.Text,
+			Difficulty:        reviewedQuestion.Question.Difficulty,
+			SelectedAnswerID:  reviewedQuestion.SelectedAnswerID,
+			SelectedAnswerIDs: selectedAnswerIDs,
+			IsCorrect:         reviewedQuestion.IsCorrect,
+			Revealed:          reviewedQuestion.Revealed,
+			CorrectAnswerIDs:  correctAnswerIDs,
+			Explanation:       explanation,
+			TimeSpentSecs:     reviewedQuestion.TimeSpentSecs,
+			UsedHints:         usedHints,
+			Answers:           responseAnswers,
+		})
+	}
+
+	responseTimeline := []responseobject.AnswerChange{}
+	for _, answerChange := range ar.Timeline {
+		responseTimeline = append(responseTimeline, responseobject.AnswerChange{
+			QuestionID:        answerChange.QuestionID,
+			AnswerID:          answerChange.AnswerID,
+			IsCorrect:         answerChange.IsCorrect,
+			SelectedAt:        answerChange.SelectedAt,
+			SecsSincePrevious: answerChange.SecsSincePrevious,
+		})
+	}
+
+	return responseobject.AttemptReview{
+		QuizID:    ar.QuizID,
+		AttemptID: ar.AttemptID,
+		Finished:  ar.Finished,
+		Questions: responseQuestions,
+		Timeline:  responseTimeline,
+	}
+}
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/command"
+	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
+)
+
+type CommandResultMapper struct {
+}
+
+func NewCommandResultMapper() *CommandResultMapper {
+	return &CommandResultMapper{}
+}
+
+func (cm *CommandResultMapper) EntityToResponseObject(result command.Result) responseobject.CommandResult {
+	responseObject := responseobject.CommandResult{
+		Type: result.Type,
+	}
+
+	if result.AnswerFeedback != nil {
+		correctAnswerIDs := []string{}
+		correctAnswerIDs = append(correctAnswerIDs, result.AnswerFeedback.CorrectAnswerIDs...)
+
+		responseObject.AnswerFeedback = &responseobject.AnswerFeedback{
+			QuestionID:       result.AnswerFeedback.QuestionID,
+			AnswerID:         result.AnswerFeedback.AnswerID,
+			IsCorrect:        result.AnswerFeedback.IsCorrect,
+			Explanation:      result.AnswerFeedback.Explanation,
+			CorrectAnswerIDs: correctAnswerIDs,
+			Confidence:       result.AnswerFeedback.Confidence,
+			CertaintyMark:    result.AnswerFeedback.CertaintyMark,
+		}
+	}
+
+	if result.AttemptProgress != nil {
+		responseObject.AttemptProgress = &responseobject.AttemptProgress{
+			AttemptID:         result.AttemptProgress.AttemptID,
+			AnsweredQuestions: result.AttemptProgress.AnsweredQuestions,
+			TotalQuestions:    result.AttemptProgress.TotalQuestions,
+			Completed:         result.AttemptProgress.Completed,
+			Practice:          result.AttemptProgress.Practice,
+		}
+	}
+
+	if result.Hint != nil {
+		responseObject.Hint = &responseobject.Hint{
+			QuestionID:     result.Hint.QuestionID,
+			Index:          result.Hint.Index,
+			Text:           result.Hint.Text,
+			Penalty:        result.Hint.Penalty,
+			RemainingHints: result.Hint.RemainingHints,
+		}
+	}
+
+	if result.AttemptResult != nil {
+		attemptResult := mapAttemptResult(*result.AttemptResult)
+		responseObject.AttemptResult = &attemptResult
+	}
+
+	return responseObject
+}
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant"
+	coursemapper "learn-to-code/internal/interfaces/lambda/course/mapper"
+	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
+)
+
+type NextQuestionMapper struct {
+	courseMapper *coursemapper.CourseMapper
+}
+
+func NewNextQuestionMapper(courseMapper *coursemapper.CourseMapper) *NextQuestionMapper {
+	return &NextQuestionMapper{
+		courseMapper: courseMapper,
+	}
+}
+
+func (nm *NextQuestionMapper) EntityToResponseObject(nextQuestion participant.NextQuestion) responseobject.NextQuestion {
+	responseObject := responseobject.NextQuestion{
+		Finished:     nextQuestion.Finished,
+		AbilityScore: nextQuestion.AbilityScore,
+	}
+
+	if !nextQuestion.Finished {
+		question := nm.courseMapper.QuestionToResponseObject(nextQuestion.Question)
+		responseObject.Question = &question
+	}
+
+	return responseObject
+}
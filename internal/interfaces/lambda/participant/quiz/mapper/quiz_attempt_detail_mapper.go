@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"learn-to-code/internal/domain/quiz/participant/calculator"
 	"learn-to-code/internal/domain/quiz/participant/projection/quizattemptdetail"
 	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
 )
@@ -19,15 +20,42 @@ func (cm *QuizAttemptDetailMapper) EntityToResponseObject(qad quizattemptdetail.
 		AttemptStatus:       string(qad.AttemptStatus),
 		AttemptID:           qad.AttemptID,
 		AttemptResult:       mapAttemptResult(qad.AttemptResult),
+		TimeLimitMins:       qad.TimeLimitMins,
+		RemainingTimeSecs:   qad.RemainingTimeSecs,
+		Adaptive:            qad.Adaptive,
+		AbilityScore:        qad.AbilityScore,
+		Practice:            qad.Practice,
+		RetakeOfAttemptID:   qad.RetakeOfAttemptID,
+		HintsUsed:           qad.HintsUsed,
+		Calibration:         mapCalibration(qad.Calibration),
 	}
 }
 
 func mapAttemptResult(domainObject quizattemptdetail.AttemptResult) responseobject.AttemptResult {
 	return responseobject.AttemptResult{
 		Pass:                                    domainObject.Pass,
+		Graded:                                  domainObject.Graded,
 		QuestionCorrectRatio:                    domainObject.QuestionCorrectRatio,
 		TimeTakenMins:                           domainObject.TimeTakenMins,
 		ComparedToTimeAveragePercentage:         domainObject.ComparedToTimeAveragePercentage,
 		ComparedToCorrectRatioLastTryPercentage: domainObject.ComparedToCorrectRatioLastTryPercentage,
+		AverageTimePerQuestionSecs:              domainObject.AverageTimePerQuestionSecs,
+	}
+}
+
+func mapCalibration(domainObject calculator.Calibration) responseobject.Calibration {
+	levels := make([]responseobject.CalibrationLevel, 0, len(domainObject.Levels))
+	for _, level := range domainObject.Levels {
+		levels = append(levels, responseobject.CalibrationLevel{
+			Confidence:     level.Confidence,
+			Answers:        level.Answers,
+			CorrectAnswers: level.CorrectAnswers,
+			Accuracy:       level.GetAccuracy(),
+		})
+	}
+
+	return responseobject.Calibration{
+		Levels:         levels,
+		CertaintyScore: domainObject.CertaintyScore,
 	}
 }
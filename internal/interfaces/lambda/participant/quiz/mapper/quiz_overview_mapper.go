@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/projection"
 	responseobject "learn-to-code/internal/interfaces/lambda/participant/quiz/responseobject"
 )
@@ -17,9 +18,31 @@ func (cm *QuizOverviewMapper) EntityToResponseObject(p projection.QuizOverview)
 	return responseobject.QuizOverview{
 		ActiveQuizzes:   cm.toAttemptDetailResponseObjects(p.ActiveQuizzes),
 		FinishedQuizzes: cm.toAttemptDetailResponseObjects(p.FinishedQuizzes),
+		NextAttempts:    cm.toNextAttemptResponseObjects(p.NextAttempts),
 	}
 }
 
+func (cm *QuizOverviewMapper) toNextAttemptResponseObjects(nextAttemptEntities map[string]participant.NextAttempt) map[string]responseobject.NextAttempt {
+	nextAttemptResponses := map[string]responseobject.NextAttempt{}
+
+	for quizID, nextAttemptEntity := range nextAttemptEntities {
+		nextAttemptResponse := responseobject.NextAttempt{
+			Allowed:           nextAttemptEntity.Allowed,
+			RemainingAttempts: nextAttemptEntity.RemainingAttempts,
+		}
+
+		// without attempts left or during an ongoing attempt there is no time the next attempt is allowed at
+		if !nextAttemptEntity.AllowedAt.IsZero() {
+			allowedAt := nextAttemptEntity.AllowedAt
+			nextAttemptResponse.AllowedAt = &allowedAt
+		}
+
+		nextAttemptResponses[quizID] = nextAttemptResponse
+	}
+
+	return nextAttemptResponses
+}
+
 func (cm *QuizOverviewMapper) toAttemptDetailResponseObjects(attemptEntities map[string][]projection.QuizAttemptOverview) map[string][]responseobject.QuizAttemptOverview {
 	attemptResponses := map[string][]responseobject.QuizAttemptOverview{}
 
@@ -29,8 +52,14 @@ func (cm *QuizOverviewMapper) toAttemptDetailResponseObjects(attemptEntities map
 				AttemptID:            attemptOverviewEntity.AttemptID,
 				QuizID:               attemptOverviewEntity.QuizID,
 				Pass:                 attemptOverviewEntity.Pass,
+				Graded:               attemptOverviewEntity.Graded,
 				QuestionsWithAnswer:  attemptOverviewEntity.QuestionsWithAnswer,
 				QuestionCorrectRatio: attemptOverviewEntity.QuestionCorrectRatio,
+				TimedOut:             attemptOverviewEntity.TimedOut,
+				TimeLimitMins:        attemptOverviewEntity.TimeLimitMins,
+				RemainingTimeSecs:    attemptOverviewEntity.RemainingTimeSecs,
+				Practice:             attemptOverviewEntity.Practice,
+				RetakeOfAttemptID:    attemptOverviewEntity.RetakeOfAttemptID,
 			})
 		}
 
@@ -1,12 +1,14 @@
 package mapper_test
 
 import (
+	"learn-to-code/internal/domain/quiz/participant"
 	"learn-to-code/internal/domain/quiz/participant/projection"
 	"learn-to-code/internal/infrastructure/inmemory"
 	"learn-to-code/internal/infrastructure/testing/assertgo"
 	"learn-to-code/internal/interfaces/lambda/participant/quiz/mapper"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestEntityToResponseObject(t *testing.T) {
@@ -33,6 +35,13 @@ func TestEntityToResponseObject(t *testing.T) {
 				},
 			},
 		},
+		NextAttempts: map[string]participant.NextAttempt{
+			inmemory.QuizIDEssentialsOfTheWeb: {
+				Allowed:           false,
+				AllowedAt:         time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+				RemainingAttempts: 2,
+			},
+		},
 	}
 
 	qom := mapper.NewQuizOverviewMapper()
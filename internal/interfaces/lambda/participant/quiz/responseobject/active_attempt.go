@@ -0,0 +1,29 @@
+package responseobject
+
+import (
+	courseresponseobject "learn-to-code/internal/interfaces/lambda/course/responseobject"
+	"time"
+)
+
+type ActiveAttempt struct {
+	QuizID            string            `json:"quizId"`
+	AttemptID         int               `json:"attemptId"`
+	StartedAt         time.Time         `json:"startedAt"`
+	Adaptive          bool              `json:"adaptive"`
+	Practice          bool              `json:"practice"`
+	RetakeOfAttemptID int               `json:"retakeOfAttemptId"`
+	Questions         []ResumedQuestion `json:"questions"`
+	NextQuestionID    string            `json:"nextQuestionId"`
+	Timed             bool              `json:"timed"`
+	RemainingTimeSecs int               `json:"remainingTimeSecs"`
+	UsedHintCount     int               `json:"usedHintCount"`
+	HintPenalty       float64           `json:"hintPenalty"`
+}
+
+// ResumedQuestion contains the selected answer without its correctness, the answer key is part of the attempt review.
+type ResumedQuestion struct {
+	Question         courseresponseobject.QuizQuestion `json:"question"`
+	SelectedAnswerID string                            `json:"selectedAnswerId"`
+	Confidence       string                            `json:"confidence,omitempty"`
+	UsedHints        []string                          `json:"usedHints"`
+}
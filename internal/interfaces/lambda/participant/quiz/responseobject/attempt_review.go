@@ -0,0 +1,43 @@
+package responseobject
+
+import "time"
+
+type AttemptReview struct {
+	QuizID    string             `json:"quizId"`
+	AttemptID int                `json:"attemptId"`
+	Finished  bool               `json:"finished"`
+	Questions []ReviewedQuestion `json:"questions"`
+	Timeline  []AnswerChange     `json:"timeline"`
+}
+
+// ReviewedQuestion contains the correct answers and the explanation of the selected answer only for revealed questions.
+type ReviewedQuestion struct {
+	ID                string           `json:"id"`
+	Text              string           `json:"text"`
+	Difficulty        string           `json:"difficulty"`
+	SelectedAnswerID  string           `json:"selectedAnswerId"`
+	SelectedAnswerIDs []string         `json:"selectedAnswerIds"`
+	IsCorrect         bool             `json:"isCorrect"`
+	Revealed          bool             `json:"revealed"`
+	CorrectAnswerIDs  []string         `json:"correctAnswerIds,omitempty"`
+	Explanation       string           `json:"explanation,omitempty"`
+	TimeSpentSecs     int              `json:"timeSpentSecs"`
+	UsedHints         []string         `json:"usedHints"`
+	Answers           []ReviewedAnswer `json:"answers"`
+}
+
+type AnswerChange struct {
+	QuestionID        string    `json:"questionId"`
+	AnswerID          string    `json:"answerId"`
+	IsCorrect         bool      `json:"isCorrect"`
+	SelectedAt        time.Time `json:"selectedAt"`
+	SecsSincePrevious int       `json:"secsSincePrevious"`
+}
+
+// ReviewedAnswer contains the correctness and the explanation only for revealed questions.
+type ReviewedAnswer struct {
+	ID          string `json:"id"`
+	Text        string `json:"text"`
+	IsCorrect   *bool  `json:"isCorrect,omitempty"`
+	Description string `json:"description,omitempty"`
+}
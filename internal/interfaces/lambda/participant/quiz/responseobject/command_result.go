@@ -0,0 +1,35 @@
+package responseobject
+
+type CommandResult struct {
+	Type            string           `json:"type"`
+	AnswerFeedback  *AnswerFeedback  `json:"answerFeedback,omitempty"`
+	AttemptProgress *AttemptProgress `json:"attemptProgress,omitempty"`
+	AttemptResult   *AttemptResult   `json:"attemptResult,omitempty"`
+	Hint            *Hint            `json:"hint,omitempty"`
+}
+
+type AnswerFeedback struct {
+	QuestionID       string   `json:"questionId"`
+	AnswerID         string   `json:"answerId"`
+	IsCorrect        bool     `json:"isCorrect"`
+	Explanation      string   `json:"explanation"`
+	CorrectAnswerIDs []string `json:"correctAnswerIds"`
+	Confidence       string   `json:"confidence"`
+	CertaintyMark    int      `json:"certaintyMark"`
+}
+
+type AttemptProgress struct {
+	AttemptID         int  `json:"attemptId"`
+	AnsweredQuestions int  `json:"answeredQuestions"`
+	TotalQuestions    int  `json:"totalQuestions"`
+	Completed         bool `json:"completed"`
+	Practice          bool `json:"practice"`
+}
+
+type Hint struct {
+	QuestionID     string  `json:"questionId"`
+	Index          int     `json:"index"`
+	Text           string  `json:"text"`
+	Penalty        float64 `json:"penalty"`
+	RemainingHints int     `json:"remainingHints"`
+}
@@ -0,0 +1,9 @@
+package responseobject
+
+import "time"
+
+type NextAttempt struct {
+	Allowed           bool       `json:"allowed"`
+	AllowedAt         *time.Time `json:"allowedAt,omitempty"`
+	RemainingAttempts int        `json:"remainingAttempts"`
+}
@@ -0,0 +1,9 @@
+package responseobject
+
+import courseresponseobject "learn-to-code/internal/interfaces/lambda/course/responseobject"
+
+type NextQuestion struct {
+	Finished     bool                               `json:"finished"`
+	Question     *courseresponseobject.QuizQuestion `json:"question,omitempty"`
+	AbilityScore float64                            `json:"abilityScore"`
+}
@@ -2,10 +2,12 @@ package responseobject
 
 type AttemptResult struct {
 	Pass                                    bool    `json:"pass"`
+	Graded                                  bool    `json:"graded"`
 	QuestionCorrectRatio                    float64 `json:"questionCorrectRatio"`
 	TimeTakenMins                           int     `json:"timeTakenMins"`
 	ComparedToTimeAveragePercentage         int     `json:"comparedToTimeAveragePercentage"`
 	ComparedToCorrectRatioLastTryPercentage int     `json:"comparedToCorrectRatioLastTryPercentage"`
+	AverageTimePerQuestionSecs              float64 `json:"averageTimePerQuestionSecs"`
 }
 
 type QuizAttemptDetail struct {
@@ -13,4 +15,24 @@ type QuizAttemptDetail struct {
 	AttemptStatus       string            `json:"attemptStatus"`
 	AttemptID           int               `json:"attemptId"`
 	AttemptResult       AttemptResult     `json:"attemptResult"`
+	TimeLimitMins       int               `json:"timeLimitMins"`
+	RemainingTimeSecs   int               `json:"remainingTimeSecs"`
+	Adaptive            bool              `json:"adaptive"`
+	AbilityScore        float64           `json:"abilityScore"`
+	Practice            bool              `json:"practice"`
+	RetakeOfAttemptID   int               `json:"retakeOfAttemptId"`
+	HintsUsed           map[string]int    `json:"hintsUsed"`
+	Calibration         Calibration       `json:"calibration"`
+}
+
+type Calibration struct {
+	Levels         []CalibrationLevel `json:"levels"`
+	CertaintyScore int                `json:"certaintyScore"`
+}
+
+type CalibrationLevel struct {
+	Confidence     string  `json:"confidence"`
+	Answers        int     `json:"answers"`
+	CorrectAnswers int     `json:"correctAnswers"`
+	Accuracy       float64 `json:"accuracy"`
 }
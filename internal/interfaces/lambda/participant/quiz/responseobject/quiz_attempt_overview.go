@@ -5,5 +5,11 @@ type QuizAttemptOverview struct {
 	QuizID               string            `json:"quizId"`
 	QuestionsWithAnswer  map[string]string `json:"questionsWithAnswer"`
 	Pass                 bool              `json:"pass"`
+	Graded               bool              `json:"graded"`
 	QuestionCorrectRatio float64           `json:"questionCorrectRatio"`
+	TimedOut             bool              `json:"timedOut"`
+	TimeLimitMins        int               `json:"timeLimitMins"`
+	RemainingTimeSecs    int               `json:"remainingTimeSecs"`
+	Practice             bool              `json:"practice"`
+	RetakeOfAttemptID    int               `json:"retakeOfAttemptId"`
 }
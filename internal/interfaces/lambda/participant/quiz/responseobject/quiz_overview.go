@@ -3,4 +3,5 @@ package responseobject
 type QuizOverview struct {
 	ActiveQuizzes   map[string][]QuizAttemptOverview `json:"activeQuizzes"`
 	FinishedQuizzes map[string][]QuizAttemptOverview `json:"finishedQuizzes"`
+	NextAttempts    map[string]NextAttempt           `json:"nextAttempts"`
 }
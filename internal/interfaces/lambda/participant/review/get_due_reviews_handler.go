@@ -0,0 +1,39 @@
+package review
+
+import (
+	"context"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type GetDueReviewsHandler struct {
+	lambda.HandlerBase
+}
+
+func NewGetParticipantDueReviewsHandler(cfg config.Config, registryOverride service.RegistryOverride) lambda.Handler {
+	return &GetDueReviewsHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (gh *GetDueReviewsHandler) HandleRequest(ctx context.Context, request events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	serviceRegistry := service.NewServiceRegistry(ctx, gh.Cfg, gh.RegistryOverrides...)
+
+	userID, err := serviceRegistry.RequestValidator.ValidateRequest(request)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateClientErrorResponse(err)
+	}
+
+	dueReviews, err := serviceRegistry.ParticipantApplicationService.GetDueReviews(userID)
+	if err != nil {
+		return serviceRegistry.ResponseCreator.CreateServerErrorResponse(err)
+	}
+
+	return serviceRegistry.ResponseCreator.CreateSuccessResponse(serviceRegistry.DueReviewsMapper.EntityToResponseObject(dueReviews))
+}
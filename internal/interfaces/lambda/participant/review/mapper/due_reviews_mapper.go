@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/projection/reviewqueue"
+	"learn-to-code/internal/interfaces/lambda/participant/review/responseobject"
+)
+
+type DueReviewsMapper struct {
+}
+
+func NewDueReviewsMapper() *DueReviewsMapper {
+	return &DueReviewsMapper{}
+}
+
+func (dm *DueReviewsMapper) EntityToResponseObject(reviewItems []reviewqueue.ReviewItem) responseobject.DueReviews {
+	dueReviews := []responseobject.ReviewItem{}
+
+	for _, reviewItem := range reviewItems {
+		dueReviews = append(dueReviews, responseobject.ReviewItem{
+			QuizID:       reviewItem.QuizID,
+			QuestionID:   reviewItem.QuestionID,
+			Repetitions:  reviewItem.Repetitions,
+			IntervalDays: reviewItem.IntervalDays,
+			EaseFactor:   reviewItem.EaseFactor,
+			DueAt:        reviewItem.DueAt,
+		})
+	}
+
+	return responseobject.DueReviews{
+		DueReviews: dueReviews,
+	}
+}
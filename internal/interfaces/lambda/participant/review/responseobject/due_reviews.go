@@ -0,0 +1,16 @@
+package responseobject
+
+import "time"
+
+type DueReviews struct {
+	DueReviews []ReviewItem `json:"dueReviews"`
+}
+
+type ReviewItem struct {
+	QuizID       string    `json:"quizId"`
+	QuestionID   string    `json:"questionId"`
+	Repetitions  int       `json:"repetitions"`
+	IntervalDays int       `json:"intervalDays"`
+	EaseFactor   float64   `json:"easeFactor"`
+	DueAt        time.Time `json:"dueAt"`
+}
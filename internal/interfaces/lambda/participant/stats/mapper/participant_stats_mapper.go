@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"learn-to-code/internal/domain/quiz/participant/calculator"
+	"learn-to-code/internal/domain/quiz/participant/projection/participantstats"
+	"learn-to-code/internal/interfaces/lambda/participant/stats/responseobject"
+)
+
+type ParticipantStatsMapper struct {
+}
+
+func NewParticipantStatsMapper() *ParticipantStatsMapper {
+	return &ParticipantStatsMapper{}
+}
+
+func (sm *ParticipantStatsMapper) EntityToResponseObject(ps participantstats.ParticipantStats) responseobject.ParticipantStats {
+	weeklyCalibrations := make([]responseobject.WeeklyCalibration, 0, len(ps.WeeklyCalibrations))
+	for _, weeklyCalibration := range ps.WeeklyCalibrations {
+		weeklyCalibrations = append(weeklyCalibrations, responseobject.WeeklyCalibration{
+			Week:        weeklyCalibration.Week,
+			Calibration: mapCalibration(weeklyCalibration.Calibration),
+		})
+	}
+
+	return responseobject.ParticipantStats{
+		Timezone:            ps.Timezone,
+		TotalXP:             ps.TotalXP,
+		XPToday:             ps.XPToday,
+		CurrentStreakDays:   ps.CurrentStreakDays,
+		LongestStreakDays:   ps.LongestStreakDays,
+		AvailableFreezeDays: ps.AvailableFreezeDays,
+		LastActiveDay:       ps.LastActiveDay,
+		Calibration:         mapCalibration(ps.Calibration),
+		WeeklyCalibrations:  weeklyCalibrations,
+	}
+}
+
+func mapCalibration(domainObject calculator.Calibration) responseobject.Calibration {
+	levels := make([]responseobject.CalibrationLevel, 0, len(domainObject.Levels))
+	for _, level := range domainObject.Levels {
+		levels = append(levels, responseobject.CalibrationLevel{
+			Confidence:     level.Confidence,
+			Answers:        level.Answers,
+			CorrectAnswers: level.CorrectAnswers,
+			Accuracy:       level.GetAccuracy(),
+		})
+	}
+
+	return responseobject.Calibration{
+		Levels:         levels,
+		CertaintyScore: domainObject.CertaintyScore,
+	}
+}
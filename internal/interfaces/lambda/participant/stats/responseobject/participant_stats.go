@@ -0,0 +1,31 @@
+package responseobject
+
+type ParticipantStats struct {
+	Timezone            string `json:"timezone"`
+	TotalXP             int    `json:"totalXp"`
+	XPToday             int    `json:"xpToday"`
+	CurrentStreakDays   int    `json:"currentStreakDays"`
+	LongestStreakDays   int    `json:"longestStreakDays"`
+	AvailableFreezeDays int    `json:"availableFreezeDays"`
+	LastActiveDay       string `json:"lastActiveDay"`
+
+	Calibration        Calibration         `json:"calibration"`
+	WeeklyCalibrations []WeeklyCalibration `json:"weeklyCalibrations"`
+}
+
+type WeeklyCalibration struct {
+	Week        string      `json:"week"`
+	Calibration Calibration `json:"calibration"`
+}
+
+type Calibration struct {
+	Levels         []CalibrationLevel `json:"levels"`
+	CertaintyScore int                `json:"certaintyScore"`
+}
+
+type CalibrationLevel struct {
+	Confidence     string  `json:"confidence"`
+	Answers        int     `json:"answers"`
+	CorrectAnswers int     `json:"correctAnswers"`
+	Accuracy       float64 `json:"accuracy"`
+}
@@ -0,0 +1,83 @@
+package questionstats
+
+import (
+	"context"
+	"learn-to-code/internal/application"
+	"learn-to-code/internal/domain/quiz/participant/event"
+	"learn-to-code/internal/infrastructure/config"
+	"learn-to-code/internal/infrastructure/service"
+	"learn-to-code/internal/interfaces/lambda"
+	"strconv"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// questionStatsChangingEventTypes end an attempt or regrade its answers, only the answered questions of ended attempts
+// feed the question stats.
+var questionStatsChangingEventTypes = map[string]bool{
+	event.FinishedQuizTypeName:   true,
+	event.QuizTimedOutTypeName:   true,
+	event.AnswerRegradedTypeName: true,
+}
+
+// StreamHandler keeps the question stats of all participants up to date with the events table. It is invoked by the
+// DynamoDB stream of the events table and updates the attempts every participant ended or had regraded from the first
+// such event in the batch on and the stats of their courses once per batch.
+type StreamHandler struct {
+	lambda.HandlerBase
+}
+
+func NewStreamHandler(cfg config.Config, registryOverride service.RegistryOverride) *StreamHandler {
+	return &StreamHandler{
+		lambda.HandlerBase{
+			Cfg:               cfg,
+			RegistryOverrides: []service.RegistryOverride{registryOverride},
+		},
+	}
+}
+
+func (sh *StreamHandler) HandleEvent(ctx context.Context, streamEvent events.DynamoDBEvent) error {
+	serviceRegistry := service.NewServiceRegistry(ctx, sh.Cfg, sh.RegistryOverrides...)
+
+	var changedParticipants []application.ChangedParticipant
+	participantIndexes := map[string]int{}
+
+	for _, record := range streamEvent.Records {
+		if record.EventName != string(events.DynamoDBOperationTypeInsert) {
+			continue
+		}
+
+		eventType, ok := record.Change.NewImage["type"]
+		if !ok || !questionStatsChangingEventTypes[eventType.String()] {
+			continue
+		}
+
+		aggregateID, ok := record.Change.NewImage["aggregate_id"]
+		if !ok {
+			continue
+		}
+
+		version, ok := record.Change.NewImage["version"]
+		if !ok {
+			continue
+		}
+
+		fromVersion, err := strconv.ParseUint(version.Number(), 10, 0)
+		if err != nil {
+			return err
+		}
+
+		if index, ok := participantIndexes[aggregateID.String()]; ok {
+			changedParticipants[index].FromVersion = min(changedParticipants[index].FromVersion, uint(fromVersion))
+			continue
+		}
+
+		participantIndexes[aggregateID.String()] = len(changedParticipants)
+		changedParticipants = append(changedParticipants, application.ChangedParticipant{
+			ParticipantID: aggregateID.String(),
+			FromVersion:   uint(fromVersion),
+		})
+	}
+
+	return serviceRegistry.QuestionStatsApplicationService.UpdateQuestionStats(changedParticipants)
+}